@@ -0,0 +1,114 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+// chanVerbs are the verbs that require a slot to be a channel.  The
+// close verb doesn't establish the type of the channel.
+var chanVerbs = []string{ "send", "receive" }
+
+// chanVerbPhrase is embedded in the VerbPhrases of the send, receive
+// and close verbs.
+type chanVerbPhrase struct {
+	slotVerbPhrase
+	element_type types.Type
+	// form identifies which of the verb's templates matched the
+	// method signature.
+	form string
+}
+
+// ElementType returns the type of the values sent on the channel.
+func (vp *chanVerbPhrase) ElementType() types.Type {
+	return vp.element_type
+}
+
+func (vp *chanVerbPhrase) Form() string {
+	return vp.form
+}
+
+// newChanVerbPhrase does the work that is common to the NewVerbPhrase
+// methods of the send, receive and close verbs.  The method signature
+// is checked against each of the named templates from tmpl in turn.
+func newChanVerbPhrase(ctx *context, vd VerbDefinition, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment, tmpl *template.Template, forms ...string) (chanVerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return chanVerbPhrase{}, err
+	}
+	vp := chanVerbPhrase{
+		slotVerbPhrase: slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+		},
+	}
+	for _, f := range forms {
+		elt, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, tmpl.Lookup(f))
+		if err == nil {
+			vp.element_type = elt
+			vp.form = f
+			break
+		}
+	}
+	pos := ctx.fset.Position(comment.Slash)
+	if vp.form == "" {
+		return chanVerbPhrase{}, fmt.Errorf("defimpl: %s: Method signature inappropriate for verb %q",
+			pos, vd.Tag())
+	}
+	if vp.element_type != nil {
+		vp.slot_type = types.NewChan(types.SendRecv, vp.element_type)
+	} else if !slotHasVerb(idef, slot, chanVerbs...) {
+		return chanVerbPhrase{}, fmt.Errorf("defimpl: %s: verb %q: slot %q needs a send or receive verb to establish its type",
+			pos, vd.Tag(), slot)
+	}
+	return vp, nil
+}
+
+// chanMutexField returns the name of the struct field holding the
+// mutex that protects the making of the named channel slot of an
+// implementation that doesn't have the (THREADSAFE) option.  Channels
+// are meant to be used concurrently, so making one mustn't race even
+// then.
+func chanMutexField(slot string) string {
+	return "defimpl_chan_mutex_" + slot
+}
+
+// chanStructBody returns the declaration of the mutex named by
+// chanMutexField if the named slot of idef needs one.
+func chanStructBody(idef *InterfaceDefinition, slot string) string {
+	if idef.HasOption("(THREADSAFE)") || !slotHasVerb(idef, slot, chanVerbs...) {
+		return ""
+	}
+	return fmt.Sprintf("\t%s sync.Mutex\n", chanMutexField(slot))
+}
+
+// Channel returns the code that declares the variable ch to be the
+// slot's channel, first giving the slot its initial value, if it has
+// the default or init option, and then making the channel if it
+// doesn't exist yet.  The channel is buffered if the slot has the
+// buffer option, e.g. buffer:"10".  This holds the mutex of a
+// (THREADSAFE) implementation, or else that named by chanMutexField.
+func (vp *chanVerbPhrase) Channel() string {
+	slot := "x." + vp.SlotName()
+	make_args := vp.TypeString(vp.SlotSpec().SlotType())
+	if size := slotOption(vp.InterfaceDefinition(), vp.SlotName(), "buffer"); size != "" {
+		make_args += ", " + size
+	}
+	mutex := "x." + chanMutexField(vp.SlotName())
+	if vp.Locked() {
+		mutex = "x.defimpl_mutex"
+	}
+	// Don't hold the mutex while using the channel, since that
+	// might block.
+	code := mutex + ".Lock()"
+	if init := vp.Initialize(); init != "" {
+		code += "\n" + init
+	}
+	return code + fmt.Sprintf("\nif %s == nil {\n\t%s = make(%s)\n}\nch := %s\n%s.Unlock()",
+		slot, slot, make_args, slot, mutex)
+}
@@ -1,6 +1,7 @@
 package main
 
 import "bytes"
+import "errors"
 import "fmt"
 import "defimpl/util"
 import "go/ast"
@@ -42,14 +43,16 @@ func CheckSignatures(ctx *context, vd VerbDefinition, pkg string, field *ast.Fie
 	fmt.Fprintf(w, "package %s\n\n", pkg)
 	if err := tmpl.Execute(w, &CheckSignaturesVerbPhraseSurrogate{
 		MethodName: MatchVar(field_name),
-		InterfaceName: MatchVar("IGNORE"),
+		InterfaceName: MatchVar("_INTERFACE"),
 		StructName: MatchVar("IGNORE"),
 		DelegateTo: MatchVar("IGNORE"),
 		SlotName: MatchVar("IGNORE"),
 		SlotType: MatchVar("_SLOT_TYPE"),
+		ElementType: MatchVar("_SLOT_TYPE"),
 		MethodParameters: MatchVar("__PARAMETERS"),
 		ParameterNames: MatchVar("IGNORE"),
 		MethodResults: MatchVar("__RESULTS"),
+		Bit: MatchVar("IGNORE"),
 		InterfaceDefinition: fakeInterfaceDefinition {
 			Package: "",
 		},
@@ -94,6 +97,15 @@ func CheckSignatures(ctx *context, vd VerbDefinition, pkg string, field *ast.Fie
 		return nil, fmt.Errorf("Method signature inappropriate for verb %q",
 			vd.Tag()), scratchpad
 	}
+	if i, ok := scratchpad["_INTERFACE"]; ok {
+		// The method returns the object itself, e.g. a fluent
+		// setter, so it must return the interface it's part of.
+		if !returnsOwnInterface(ctx, field, i.(ast.Expr)) {
+			return nil, fmt.Errorf("defimpl: %s: %s %w",
+				ctx.fset.Position(field.Comment.List[0].Slash).String(),
+				field_name, errNotOwnInterface), scratchpad
+		}
+	}
 	if t, ok := scratchpad["_SLOT_TYPE"]; ok {
 		return ctx.info.Types[t.(ast.Expr)].Type, nil, scratchpad
 	} else {
@@ -101,6 +113,27 @@ func CheckSignatures(ctx *context, vd VerbDefinition, pkg string, field *ast.Fie
 	}
 }
 
+// errNotOwnInterface is wrapped by the error that CheckSignatures
+// returns when the method matches a form that returns the object
+// itself, e.g. a fluent setter, but returns some other type.  A verb
+// that tries several forms should report it rather than a mismatch.
+var errNotOwnInterface = errors.New("should return the interface that it is part of")
+
+// returnsOwnInterface returns true if result, the result type of the
+// interface method field, is an interface that includes that method.
+func returnsOwnInterface(ctx *context, field *ast.Field, result ast.Expr) bool {
+	t := ctx.info.Types[result].Type
+	if t == nil {
+		return false
+	}
+	if _, ok := t.Underlying().(*types.Interface); !ok {
+		return false
+	}
+	obj, _, _ := types.LookupFieldOrMethod(t, false, nil, field.Names[0].Name)
+	f, ok := obj.(*types.Func)
+	return ok && f.Pos() == field.Names[0].Pos()
+}
+
 // CheckSignaturesVerbPhraseSurrogate should present the same
 // "interface" to a Template as GlobalsTemplateParameter does.  I
 // don't think Go provides a way to assert this.
@@ -111,10 +144,20 @@ type CheckSignaturesVerbPhraseSurrogate struct {
 	DelegateTo MatchVar
 	SlotName MatchVar
 	SlotType MatchVar
+	ElementType MatchVar
 	MethodParameters MatchVar
 	ParameterNames MatchVar
 	MethodResults MatchVar
 	InterfaceDefinition fakeInterfaceDefinition
+	Ordered bool
+	Sorted bool
+	Queue bool
+	Copy bool
+	ThreadSafe bool
+	Atomic string
+	Locked bool
+	Journaled bool
+	Bit MatchVar
 }
 
 
@@ -128,6 +171,12 @@ func (v MatchVar) Elem() MatchVar {
 func (v MatchVar) Tag()  MatchVar {
 	return v
 }
+
+// Key is for the key type of a map valued slot.  It needs to match
+// separately from the element type.
+func (v MatchVar) Key() MatchVar {
+	return MatchVar("_KEY_TYPE")
+}
         
 func (_ CheckSignaturesVerbPhraseSurrogate) TypeString(v MatchVar) MatchVar {
 	return v
@@ -136,3 +185,91 @@ func (_ CheckSignaturesVerbPhraseSurrogate) TypeString(v MatchVar) MatchVar {
 func (_ CheckSignaturesVerbPhraseSurrogate) Verb()  MatchVar {
 	return MatchVar("SURROGATE")
 }
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Less(a, b string) string {
+	return "false"
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Adjust(op, delta, result string) string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Initialize() string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Load(x string) string {
+	return "IGNORE"
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Store(x string, value string) string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Elements() string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Capture() string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Described() []*describedSlot {
+	return nil
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Allocate() string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Present() string {
+	return "false"
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Reset() string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) MarkPresent() string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) MarkAbsent() string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) SortFunction() string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Comparison() string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Hashing() string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Recompute() string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Channel() string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) Children() *slotChildren {
+	return &slotChildren{
+		Setter: "IGNORE",
+		Getter: "IGNORE",
+		Remove: "IGNORE",
+	}
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) BeforeMutation() string {
+	return ""
+}
+
+func (_ CheckSignaturesVerbPhraseSurrogate) AfterMutation() string {
+	return ""
+}
@@ -0,0 +1,166 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "reflect"
+import "strings"
+
+
+// slotChildren describes the children option of a slice valued slot,
+// e.g.
+//
+//	AddChild(...Node)    // defimpl:"append children" children:"SetParent"
+//	RemoveChild(Node)    // defimpl:"delete children"
+//
+// The option names the method of the element type that sets an
+// element's parent.  The method that gets it has the same name
+// without the "Set" prefix.  Elements that are added to the slot have
+// their parent set, having first been removed from any previous
+// parent by the method for the slot's delete verb.  Elements that are
+// removed have their parent set to nil.
+type slotChildren struct {
+	// Setter is the name of the method that sets the parent.
+	Setter string
+	// Getter is the name of the method that gets the parent.
+	Getter string
+	// Remove is the name of the parent's method that removes a
+	// child.
+	Remove string
+	// parent is the type of the parent.
+	parent types.Type
+}
+
+// slotVerbMethod returns the name of the method of idef that applies
+// verb to the named slot, or "".
+func slotVerbMethod(idef *InterfaceDefinition, slot string, verb string) string {
+	for _, field := range idef.Fields() {
+		if field.Comment == nil || len(field.Names) != 1 {
+			continue
+		}
+		for _, c := range field.Comment.List {
+			tag := reflect.StructTag(c.Text[2:])
+			if tag.Get("defimpl") == verb + " " + slot {
+				return field.Names[0].Name
+			}
+		}
+	}
+	return ""
+}
+
+// lookupMethod returns the signature of the named method of t, or nil.
+func lookupMethod(t types.Type, name string) *types.Signature {
+	var pkg *types.Package
+	if named, ok := t.(*types.Named); ok {
+		pkg = named.Obj().Pkg()
+	}
+	obj, _, _ := types.LookupFieldOrMethod(t, true, pkg, name)
+	if f, ok := obj.(*types.Func); ok {
+		return f.Type().(*types.Signature)
+	}
+	return nil
+}
+
+// getSlotChildren returns the slotChildren for the named slot of idef,
+// whose elements are of type elt, or nil if the slot doesn't have the
+// children option.
+func getSlotChildren(idef *InterfaceDefinition, slot string, elt types.Type) (*slotChildren, error) {
+	setter := slotOption(idef, slot, "children")
+	if setter == "" {
+		return nil, nil
+	}
+	// Maintaining parents calls methods of other objects, which
+	// would then hold their mutexes while this one's is held.
+	if idef.HasOption("(THREADSAFE)") {
+		return nil, fmt.Errorf("defimpl: children %q for slot %q can't be used with the (THREADSAFE) option",
+			setter, slot)
+	}
+	sc := &slotChildren{
+		Setter: setter,
+		Getter: strings.TrimPrefix(setter, "Set"),
+		Remove: slotVerbMethod(idef, slot, "delete"),
+	}
+	set := lookupMethod(elt, sc.Setter)
+	if set == nil || set.Params().Len() != 1 {
+		return nil, fmt.Errorf("defimpl: children %q for slot %q: %s has no method %s with one parameter",
+			setter, slot, elt, sc.Setter)
+	}
+	sc.parent = set.Params().At(0).Type()
+	get := lookupMethod(elt, sc.Getter)
+	if get == nil || get.Params().Len() != 0 || get.Results().Len() != 1 {
+		return nil, fmt.Errorf("defimpl: children %q for slot %q: %s has no method %s with one result",
+			setter, slot, elt, sc.Getter)
+	}
+	if sc.Remove == "" {
+		return nil, fmt.Errorf("defimpl: children %q for slot %q: the slot has no delete verb with which to remove a child from its previous parent",
+			setter, slot)
+	}
+	if lookupMethod(sc.parent, sc.Remove) == nil {
+		return nil, fmt.Errorf("defimpl: children %q for slot %q: the parent type %s has no method %s",
+			setter, slot, sc.parent, sc.Remove)
+	}
+	return sc, nil
+}
+
+// rejectChildren returns an error if the named slot of idef has the
+// children option, for the mutating verbs that don't maintain the
+// parents of the slot's elements.
+func rejectChildren(ctx *context, vd VerbDefinition, idef *InterfaceDefinition, slot string, comment *ast.Comment) error {
+	if slotOption(idef, slot, "children") == "" {
+		return nil
+	}
+	return fmt.Errorf("defimpl: %s: verb %q can't be used with slot %q, which has the children option",
+		ctx.fset.Position(comment.Slash), vd.Tag(), slot)
+}
+
+// Children returns the slotChildren of the slot, or nil if it doesn't
+// have the children option.
+func (svp *slotVerbPhrase) Children() *slotChildren {
+	t, ok := svp.SlotSpec().SlotType().(*types.Slice)
+	if !ok {
+		return nil
+	}
+	sc, err := getSlotChildren(svp.InterfaceDefinition(), svp.SlotName(), t.Elem())
+	if err != nil {
+		return nil
+	}
+	return sc
+}
+
+// newTreeVerbPhrase does the work that is common to the NewVerbPhrase
+// methods of the root, ancestors and walk verbs.  The slot must have
+// the children option, and the elements of the slot must be of the
+// same type as their parents.
+func newTreeVerbPhrase(ctx *context, vd VerbDefinition, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (slotVerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return slotVerbPhrase{}, err
+	}
+	elt, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return slotVerbPhrase{}, err
+	}
+	pos := ctx.fset.Position(comment.Slash)
+	sc, err := getSlotChildren(idef, slot, elt)
+	if err != nil {
+		return slotVerbPhrase{}, err
+	}
+	if sc == nil {
+		return slotVerbPhrase{}, fmt.Errorf("defimpl: %s: verb %q: slot %q has no children option",
+			pos, vd.Tag(), slot)
+	}
+	get := lookupMethod(elt, sc.Getter)
+	if !types.Identical(get.Results().At(0).Type(), elt) {
+		return slotVerbPhrase{}, fmt.Errorf("defimpl: %s: verb %q: the parent of a %s must also be a %s",
+			pos, vd.Tag(), elt, elt)
+	}
+	return slotVerbPhrase{
+		baseVerbPhrase: baseVerbPhrase{
+			verb: vd,
+			idef: idef,
+			field: field,
+		},
+		slot_name: slot,
+		slot_type: types.NewSlice(elt),
+	}, nil
+}
@@ -0,0 +1,94 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "reflect"
+import "strings"
+
+
+// computedFlag returns the name of the struct field that records
+// whether the cached value of the named computed slot is valid.
+func computedFlag(slot string) string {
+	return "defimpl_computed_" + slot
+}
+
+// computedDepends returns the names of the slots that the named
+// computed slot of idef depends on, from its depends option, e.g.
+// depends:"width,height".
+func computedDepends(idef *InterfaceDefinition, slot string) []string {
+	depends := []string{}
+	for _, d := range strings.Split(slotOption(idef, slot, "depends"), ",") {
+		if d = strings.TrimSpace(d); d != "" {
+			depends = append(depends, d)
+		}
+	}
+	return depends
+}
+
+// computedSlots returns the names of the slots of idef that have the
+// computed verb.
+func computedSlots(idef *InterfaceDefinition) []string {
+	slots := []string{}
+	for _, field := range idef.Fields() {
+		if field.Comment == nil {
+			continue
+		}
+		for _, c := range field.Comment.List {
+			split := strings.Split(reflect.StructTag(c.Text[2:]).Get("defimpl"), " ")
+			if len(split) == 2 && split[0] == "computed" {
+				slots = append(slots, split[1])
+			}
+		}
+	}
+	return slots
+}
+
+// computedDependents returns the names of the computed slots of idef
+// whose cached values become invalid when the named slot is modified.
+// A computed slot can depend on another, so this is transitive.
+func computedDependents(idef *InterfaceDefinition, slot string) []string {
+	dependents := []string{}
+	seen := map[string]bool{}
+	var walk func(string)
+	walk = func(slot string) {
+		for _, c := range computedSlots(idef) {
+			if seen[c] {
+				continue
+			}
+			for _, d := range computedDepends(idef, c) {
+				if d == slot {
+					seen[c] = true
+					dependents = append(dependents, c)
+					walk(c)
+					break
+				}
+			}
+		}
+	}
+	walk(slot)
+	return dependents
+}
+
+// invalidate returns the code that invalidates the cached values of
+// the computed slots of idef that depend on the named slot, or "".
+func invalidate(idef *InterfaceDefinition, slot string) string {
+	code := []string{}
+	for _, c := range computedDependents(idef, slot) {
+		code = append(code, fmt.Sprintf("x.%s = false", computedFlag(c)))
+	}
+	return strings.Join(code, "\n")
+}
+
+// lookupFunction returns true if the package being processed defines
+// a function, rather than a method, of the specified name.
+func lookupFunction(ctx *context, name string) bool {
+	for _, file := range ctx.astFiles {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if ok && fd.Recv == nil && fd.Name.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "strings"
+import "text/template"
+
+
+// counterVerbs are the verbs that treat a slot as a counter.  The add
+// verb only does so for numeric slots that aren't sets.
+var counterVerbs = []string{ "increment", "decrement", "add" }
+
+// counterVerbPhrase is embedded in the VerbPhrases of the increment
+// and decrement verbs and of the numeric form of the add verb.
+type counterVerbPhrase struct {
+	slotVerbPhrase
+	// form identifies which of the verb's templates matched the
+	// method signature.
+	form string
+}
+
+func (vp *counterVerbPhrase) Form() string {
+	return vp.form
+}
+
+// isNumber returns true if t is an integer or floating point type.
+func isNumber(t types.Type) bool {
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Info() & (types.IsInteger | types.IsFloat) != 0
+}
+
+// newCounterVerbPhrase does the work that is common to the
+// NewVerbPhrase methods of the counter verbs.  The method signature is
+// checked against each of the named templates from tmpl in turn.
+// Forms that don't mention the counter's type, like IncHits(), leave
+// it to some other verb, e.g. read, to establish the slot type, or
+// else it is int.
+func newCounterVerbPhrase(ctx *context, vd VerbDefinition, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment, tmpl *template.Template, forms ...string) (counterVerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return counterVerbPhrase{}, err
+	}
+	vp := counterVerbPhrase{
+		slotVerbPhrase: slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+		},
+	}
+	for _, f := range forms {
+		t, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, tmpl.Lookup(f))
+		if err == nil {
+			vp.slot_type = t
+			vp.form = f
+			break
+		}
+	}
+	pos := ctx.fset.Position(comment.Slash)
+	if vp.form == "" {
+		return counterVerbPhrase{}, fmt.Errorf("defimpl: %s: Method signature inappropriate for verb %q",
+			pos, vd.Tag())
+	}
+	if vp.slot_type != nil && !isNumber(vp.slot_type) {
+		return counterVerbPhrase{}, fmt.Errorf("defimpl: %s: verb %q: %s is not an integer or floating point type",
+			pos, vd.Tag(), vp.slot_type)
+	}
+	return vp, nil
+}
+
+// Adjust returns the statement that adds delta to the counter, or
+// subtracts it if op is "-", in which case delta should be a constant.
+// If result isn't empty, the statement also declares a variable of
+// that name holding the new value.
+func (vp *counterVerbPhrase) Adjust(op, delta, result string) string {
+	slot := "x." + vp.SlotName()
+	assign := ""
+	if result != "" {
+		assign = result + " := "
+	}
+	if atomic := vp.Atomic(); atomic != "" {
+		if op == "-" {
+			if strings.HasPrefix(atomic, "Uint") {
+				// The sync/atomic documentation's idiom
+				// for subtracting from an unsigned value.
+				t := vp.TypeString(vp.SlotSpec().SlotType())
+				if delta == "1" {
+					delta = fmt.Sprintf("^%s(0)", t)
+				} else {
+					delta = fmt.Sprintf("^(%s(%s) - 1)", t, delta)
+				}
+			} else {
+				delta = "-" + delta
+			}
+		}
+		return fmt.Sprintf("%s%s.Add(%s)", assign, slot, delta)
+	}
+	code := fmt.Sprintf("%s %s= %s", slot, op, delta)
+	if result != "" {
+		code += fmt.Sprintf("\n\t%s%s", assign, slot)
+	}
+	return code
+}
@@ -0,0 +1,117 @@
+package main
+
+import "fmt"
+import "go/types"
+import "strings"
+
+
+// describedSlot is how the templates of the string and marshal verbs
+// see a slot.
+type describedSlot struct {
+	// Name is the name of the slot.
+	Name string
+	// Value is the variable holding a copy of the slot's value.
+	Value string
+	// Present is the variable that is true if the slot has been
+	// set, or "" if none of the presence verbs concern the slot, in
+	// which case it is always described.
+	Present string
+	// Format is the fmt verb for the slot's value.
+	Format string
+}
+
+// describable returns true if values of t are data that the string
+// and marshal verbs can describe, rather than e.g. the functions of
+// the listen verb or the channel of the send verb.
+func describable(t types.Type) bool {
+	if isRuntimeType(t) {
+		return isOrderedSet(t) || isQueue(t)
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Chan, *types.Signature:
+		return false
+	case *types.Slice:
+		return describable(u.Elem())
+	case *types.Map:
+		return describable(u.Elem())
+	}
+	return true
+}
+
+// describedSlots returns the slotSpecs of idef that the string and
+// marshal verbs describe: all but computed slots, whose values derive
+// from the others, and those that aren't describable.
+func describedSlots(idef *InterfaceDefinition) []*slotSpec {
+	specs := []*slotSpec{}
+	for _, spec := range idef.SlotSpecs() {
+		t := spec.SlotType()
+		if t == nil || slotHasVerb(idef, spec.SlotName(), "computed") || !describable(t) {
+			continue
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+// describeSlots returns the code that copies the described slots of x,
+// having first given them their initial values, into the variables
+// named by the returned describedSlots.  A (THREADSAFE) implementation
+// holds its mutex only while doing so, so that describing the values,
+// which might call methods of other objects, doesn't.  The members of
+// an ordered set or the elements of a queue are copied as a slice.
+func describeSlots(idef *InterfaceDefinition) (string, []*describedSlot) {
+	code := []string{}
+	described := []*describedSlot{}
+	threadsafe := idef.HasOption("(THREADSAFE)")
+	if threadsafe {
+		code = append(code, "x.defimpl_mutex.Lock()")
+	}
+	for _, spec := range describedSlots(idef) {
+		ds := &describedSlot{
+			Name: spec.SlotName(),
+			Value: "v_" + spec.SlotName(),
+			Format: "%v",
+		}
+		if b, ok := spec.SlotType().Underlying().(*types.Basic); ok && b.Info() & types.IsString != 0 {
+			ds.Format = "%q"
+		}
+		if init := spec.Initialize(); init != "" {
+			code = append(code, init)
+		}
+		value := spec.Load("x")
+		if t := spec.SlotType(); isOrderedSet(t) {
+			value = "x." + spec.SlotName() + ".Members()"
+		} else if isQueue(t) {
+			value = "x." + spec.SlotName() + ".Elements()"
+		}
+		code = append(code, fmt.Sprintf("%s := %s", ds.Value, value))
+		if tracksPresence(idef, spec.SlotName()) {
+			ds.Present = "set_" + spec.SlotName()
+			code = append(code, fmt.Sprintf("%s := %s", ds.Present, spec.Presence("x")))
+		}
+		described = append(described, ds)
+	}
+	if threadsafe {
+		code = append(code, "x.defimpl_mutex.Unlock()")
+	}
+	return strings.Join(code, "\n"), described
+}
+
+// describingVerbPhrase is embedded in the VerbPhrases of the string
+// and marshal verbs.
+type describingVerbPhrase struct {
+	baseVerbPhrase
+}
+
+// Capture returns the code that copies the described slots.
+func (vp *describingVerbPhrase) Capture() string {
+	code, _ := describeSlots(vp.InterfaceDefinition())
+	return code
+}
+
+// Described returns the describedSlots for the variables that Capture
+// declares.
+func (vp *describingVerbPhrase) Described() []*describedSlot {
+	_, described := describeSlots(vp.InterfaceDefinition())
+	return described
+}
@@ -0,0 +1,320 @@
+package main
+
+import "fmt"
+import "go/types"
+import "reflect"
+import "strings"
+
+
+// valueOption returns the value of the option named by key from the
+// defimpl comments of the methods of idef with the equal or hash verb.
+// Like a slot option, it need only appear in one of them.  The options
+// are
+//
+//	slots:"name,kind"  compare only the named slots rather than all
+//	                   of those that can be compared;
+//	by:"value"         compare slot values that have an Equal method,
+//	                   e.g. other defimpl types, with it rather than
+//	                   by identity.
+func valueOption(idef *InterfaceDefinition, key string) string {
+	for _, field := range idef.Fields() {
+		if field.Comment == nil {
+			continue
+		}
+		for _, c := range field.Comment.List {
+			tag := reflect.StructTag(c.Text[2:])
+			verb := strings.Split(tag.Get("defimpl"), " ")[0]
+			if verb != "equal" && verb != "hash" {
+				continue
+			}
+			if opt, ok := tag.Lookup(key); ok {
+				return opt
+			}
+		}
+	}
+	return ""
+}
+
+// valueComparison generates the code that the equal and hash verbs
+// use to compare and hash the slots of an interface's struct.  Both
+// must treat the slots the same way so that equal objects have equal
+// hashes.
+type valueComparison struct {
+	idef *InterfaceDefinition
+	by_value bool
+}
+
+func newValueComparison(idef *InterfaceDefinition) *valueComparison {
+	return &valueComparison{
+		idef: idef,
+		by_value: valueOption(idef, "by") == "value",
+	}
+}
+
+func (vc *valueComparison) typeString(t types.Type) string {
+	return types.TypeString(t, vc.idef.File.Qualifier)
+}
+
+// hasEqualMethod returns true if t has a method Equal(t) bool.
+func hasEqualMethod(t types.Type) bool {
+	sig := lookupMethod(t, "Equal")
+	return sig != nil && sig.Params().Len() == 1 &&
+		types.Identical(sig.Params().At(0).Type(), t) && returnsBool(sig)
+}
+
+// hasHashMethod returns true if t has a method Hash() uint64.
+func hasHashMethod(t types.Type) bool {
+	sig := lookupMethod(t, "Hash")
+	if sig == nil || sig.Params().Len() != 0 || sig.Results().Len() != 1 {
+		return false
+	}
+	return types.Identical(sig.Results().At(0).Type(), types.Typ[types.Uint64])
+}
+
+// nilable returns true if the values of t can be nil.
+func nilable(t types.Type) bool {
+	switch t.Underlying().(type) {
+	case *types.Pointer, *types.Interface:
+		return true
+	}
+	return false
+}
+
+// deep returns true if values of t are compared with their Equal
+// method.
+func (vc *valueComparison) deep(t types.Type) bool {
+	return vc.by_value && hasEqualMethod(t)
+}
+
+// equal returns an expression that is true if the expressions a and
+// b, of type t, are equal, and false if t can't be compared.  depth
+// distinguishes the variables of nested function literals.
+func (vc *valueComparison) equal(t types.Type, a, b string, depth int) (string, bool) {
+	if vc.deep(t) {
+		if nilable(t) {
+			return fmt.Sprintf("((%s == nil) == (%s == nil) && (%s == nil || %s.Equal(%s)))",
+				a, b, a, a, b), true
+		}
+		return fmt.Sprintf("%s.Equal(%s)", a, b), true
+	}
+	if isRuntimeType(t) {
+		return "", false
+	}
+	e1, e2 := fmt.Sprintf("e1_%d", depth), fmt.Sprintf("e2_%d", depth)
+	switch u := t.Underlying().(type) {
+	case *types.Slice:
+		e, ok := vc.equal(u.Elem(), e1, e2, depth + 1)
+		if !ok {
+			return "", false
+		}
+		if e == e1 + " == " + e2 {
+			return fmt.Sprintf("slices.Equal(%s, %s)", a, b), true
+		}
+		return fmt.Sprintf("slices.EqualFunc(%s, %s, func(%s, %s %s) bool { return %s })",
+			a, b, e1, e2, vc.typeString(u.Elem()), e), true
+	case *types.Map:
+		e, ok := vc.equal(u.Elem(), e1, e2, depth + 1)
+		if !ok {
+			return "", false
+		}
+		if e == e1 + " == " + e2 {
+			return fmt.Sprintf("maps.Equal(%s, %s)", a, b), true
+		}
+		return fmt.Sprintf("maps.EqualFunc(%s, %s, func(%s, %s %s) bool { return %s })",
+			a, b, e1, e2, vc.typeString(u.Elem()), e), true
+	}
+	if types.Comparable(t) {
+		return a + " == " + b, true
+	}
+	return "", false
+}
+
+// hash returns the statements that add the value of the expression v,
+// of type t, to the runtime.Hash h.  Values that are compared with
+// their Equal method are hashed with their Hash method, or not at all
+// if they have none.
+func (vc *valueComparison) hash(t types.Type, v, h string, depth int) (string, bool) {
+	if vc.deep(t) {
+		if !hasHashMethod(t) {
+			return "", true
+		}
+		if nilable(t) {
+			return fmt.Sprintf("if %s != nil {\n%s.WriteUint64(%s.Hash())\n}", v, h, v), true
+		}
+		return fmt.Sprintf("%s.WriteUint64(%s.Hash())", h, v), true
+	}
+	if isRuntimeType(t) {
+		return "", false
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Slice:
+		e := fmt.Sprintf("e_%d", depth)
+		body, ok := vc.hash(u.Elem(), e, h, depth + 1)
+		if !ok {
+			return "", false
+		}
+		code := fmt.Sprintf("%s.WriteUint64(uint64(len(%s)))", h, v)
+		if body != "" {
+			code += fmt.Sprintf("\nfor _, %s := range %s {\n%s\n}", e, v, body)
+		}
+		return code, true
+	case *types.Map:
+		// The hash of a map mustn't depend on the order of
+		// iteration, so the hashes of the entries are summed.
+		k := fmt.Sprintf("k_%d", depth)
+		e := fmt.Sprintf("e_%d", depth)
+		eh := fmt.Sprintf("h_%d", depth)
+		sum := fmt.Sprintf("sum_%d", depth)
+		body, ok := vc.hash(u.Elem(), e, eh, depth + 1)
+		if !ok {
+			return "", false
+		}
+		vars := k
+		if body != "" {
+			vars += ", " + e
+		}
+		return fmt.Sprintf(`var %s uint64
+for %s := range %s {
+%s := runtime.NewHash()
+runtime.HashComparable(%s, %s)
+%s
+%s += %s.Sum64()
+}
+%s.WriteUint64(%s)`,
+			sum, vars, v, eh, eh, k, body, sum, eh, h, sum), true
+	}
+	if types.Comparable(t) {
+		return fmt.Sprintf("runtime.HashComparable(%s, %s)", h, v), true
+	}
+	return "", false
+}
+
+// slotVariable returns the name of the variable into which
+// captureSlots copies the named slot of the object o.
+func slotVariable(o string, slot string) string {
+	return o + "_" + slot
+}
+
+// captureSlots returns the code that copies the slots of specs of each
+// of the objects into the variables named by slotVariable, having first
+// given them their initial values, as the verbs that read them would.
+// The mutex of a (THREADSAFE) object is held only while its own slots
+// are copied, so that two objects are never locked at once, and the
+// copies of its collections then don't share storage with its slots.
+func captureSlots(idef *InterfaceDefinition, specs []*slotSpec, objects ...string) string {
+	threadsafe := idef.HasOption("(THREADSAFE)")
+	code := []string{}
+	for _, o := range objects {
+		if threadsafe {
+			code = append(code, o + ".defimpl_mutex.Lock()")
+		}
+		seen := map[string]bool{}
+		for _, spec := range specs {
+			if seen[spec.SlotName()] {
+				continue
+			}
+			seen[spec.SlotName()] = true
+			if init := spec.InitializeObject(o); init != "" {
+				code = append(code, init)
+			}
+			value := o + "." + spec.SlotName()
+			if threadsafe || spec.Atomic() != "" {
+				value = spec.Load(o)
+			}
+			code = append(code, fmt.Sprintf("%s := %s", slotVariable(o, spec.SlotName()), value))
+		}
+		if threadsafe {
+			code = append(code, o + ".defimpl_mutex.Unlock()")
+		}
+	}
+	return strings.Join(code, "\n")
+}
+
+// slots returns the slotSpecs that are compared and hashed: those named
+// by the slots option, or else every slot that can be compared except
+// for computed slots, whose values are derived from the others.
+func (vc *valueComparison) slots() ([]*slotSpec, error) {
+	names := []string{}
+	for _, name := range strings.Split(valueOption(vc.idef, "slots"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	specs := []*slotSpec{}
+	if len(names) == 0 {
+		for _, spec := range vc.idef.SlotSpecs() {
+			if spec.SlotType() == nil || slotHasVerb(vc.idef, spec.SlotName(), "computed") {
+				continue
+			}
+			if _, ok := vc.equal(spec.SlotType(), "a", "b", 0); ok {
+				specs = append(specs, spec)
+			}
+		}
+		return specs, nil
+	}
+	for _, name := range names {
+		var found *slotSpec
+		for _, spec := range vc.idef.SlotSpecs() {
+			if spec.SlotName() == name {
+				found = spec
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("defimpl: %s: slots option: no slot %q",
+				vc.idef.InterfaceName, name)
+		}
+		if slotHasVerb(vc.idef, name, "computed") {
+			return nil, fmt.Errorf("defimpl: %s: slots option: slot %q is computed from the others",
+				vc.idef.InterfaceName, name)
+		}
+		if _, ok := vc.equal(found.SlotType(), "a", "b", 0); !ok {
+			return nil, fmt.Errorf("defimpl: %s: slots option: slot %q of type %s can't be compared",
+				vc.idef.InterfaceName, name, found.SlotType())
+		}
+		specs = append(specs, found)
+	}
+	return specs, nil
+}
+
+// Comparison returns the statements that return true if the slots of
+// the objects x and o are equal.
+func (vc *valueComparison) Comparison() (string, error) {
+	specs, err := vc.slots()
+	if err != nil {
+		return "", err
+	}
+	if len(specs) == 0 {
+		return "return true", nil
+	}
+	terms := []string{}
+	for _, spec := range specs {
+		e, _ := vc.equal(spec.SlotType(),
+			slotVariable("x", spec.SlotName()), slotVariable("o", spec.SlotName()), 0)
+		terms = append(terms, e)
+	}
+	return fmt.Sprintf("%s\nreturn %s",
+		captureSlots(vc.idef, specs, "x", "o"),
+		strings.Join(terms, " &&\n")), nil
+}
+
+// Hashing returns the statements that add the slots of the object x
+// to the runtime.Hash h.
+func (vc *valueComparison) Hashing() (string, error) {
+	specs, err := vc.slots()
+	if err != nil {
+		return "", err
+	}
+	hashed := []*slotSpec{}
+	code := []string{}
+	for _, spec := range specs {
+		stmts, _ := vc.hash(spec.SlotType(), slotVariable("x", spec.SlotName()), "h", 0)
+		if stmts != "" {
+			hashed = append(hashed, spec)
+			code = append(code, stmts)
+		}
+	}
+	if len(hashed) == 0 {
+		return "", nil
+	}
+	return captureSlots(vc.idef, hashed, "x") + "\n" + strings.Join(code, "\n"), nil
+}
@@ -35,6 +35,10 @@ type ToImport struct {
 
 func (f *File) Qualifier(pkg *types.Package) string {
 	ppath := pkg.Path()
+	// The output file always imports the runtime package.
+	if ppath == RuntimePackagePath {
+		return "runtime"
+	}
 	for _, ispec := range f.AstFile.Imports {
 		unq, err := strconv.Unquote(ispec.Path.Value)
 		if err != nil {
@@ -81,7 +85,7 @@ func NewFile(ctx *context, astFile *ast.File) *File {
 }
 
 func (f *File) Write(ctx *context) error {
-	if !f.AnyStructs() {
+	if !f.AnyOutput() {
 		return nil
 	}
 	output := f.OutputFilePath()
@@ -107,6 +111,20 @@ func (f *File) AnyStructs() bool {
 	return false
 }
 
+// AnyOutput returns true if anything is to be generated for the File:
+// impl structs or the global definitions of AbstractOptions.
+func (f *File) AnyOutput() bool {
+	if f.AnyStructs() {
+		return true
+	}
+	for _, i := range f.Interfaces {
+		if !i.DefinesStruct() && len(i.AbstractOptions()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (f *File) GenerateCode(ctx *context, filepath string) {
 	writer := bytes.NewBufferString("")
 	err := OutputFileTemplate.Execute(writer, f)
@@ -133,20 +151,27 @@ func (f *File) GenerateCode(ctx *context, filepath string) {
 var OutputFileTemplate *template.Template = template.Must(template.New("OutputFileTemplate").Funcs(map[string]interface{}{
 	//		"NormalizedType": util.NormalizedType,
 	"GlobalDefinitions": GlobalDefinitions,
+	"OptionGlobalDefinitions": OptionGlobalDefinitions,
 }).Parse(`
 // This file was automatically generated by {{.Defimpl}} from {{.InputFilePath}}.
 package {{.Package}}
 
+{{if .AnyStructs -}}
 import "reflect"
 import "defimpl/runtime"
+{{- end}}
 
 {{with $file := . -}}
 	{{- range .Interfaces -}}
 		{{- if .DefinesStruct -}}
+			{{- $idef := . -}}
 			type {{.StructName}} struct {
 				{{- range .VerbPhrases}}
 					{{.Verb.StructBody .}}
 				{{- end -}}
+				{{- range .Options}}
+					{{.StructBody $idef}}
+				{{- end -}}
 			}
 
 			var _ {{.InterfaceName}} = (*{{.StructName}})(nil)
@@ -159,6 +184,14 @@ import "defimpl/runtime"
 			{{range .VerbPhrases -}}
 				{{GlobalDefinitions .}}
 			{{- end -}}
+			{{range .Options -}}
+				{{OptionGlobalDefinitions . $idef}}
+			{{- end -}}
+		{{- else -}}
+			{{- $idef := . -}}
+			{{range .AbstractOptions -}}
+				{{OptionGlobalDefinitions . $idef}}
+			{{- end -}}
 		{{- end -}}
 	{{- end -}}
 {{- end}}
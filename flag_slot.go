@@ -0,0 +1,128 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/token"
+import "go/types"
+import "reflect"
+import "text/template"
+
+
+// flagVerbPhrase is embedded in the VerbPhrases of the verbs that
+// concern a bool slot: enable, disable, toggle and is, and in
+// bitVerbPhrase.
+type flagVerbPhrase struct {
+	slotVerbPhrase
+	// form identifies which of the verb's templates matched the
+	// method signature.
+	form string
+}
+
+func (vp *flagVerbPhrase) Form() string {
+	return vp.form
+}
+
+// newFlagVerbPhrase does the work that is common to the NewVerbPhrase
+// methods of the flag verbs.  The method signature is checked against
+// each of the named templates from tmpl in turn.  None of them mention
+// the slot type, which is bool.
+func newFlagVerbPhrase(ctx *context, vd VerbDefinition, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment, tmpl *template.Template, forms ...string) (flagVerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return flagVerbPhrase{}, err
+	}
+	vp := flagVerbPhrase{
+		slotVerbPhrase: slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+			slot_type: types.Typ[types.Bool],
+		},
+	}
+	for _, f := range forms {
+		if _, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, tmpl.Lookup(f)); err == nil {
+			vp.form = f
+			break
+		}
+	}
+	if vp.form == "" {
+		return flagVerbPhrase{}, fmt.Errorf("defimpl: %s: Method signature inappropriate for verb %q",
+			ctx.fset.Position(comment.Slash), vd.Tag())
+	}
+	return vp, nil
+}
+
+
+// bitVerbPhrase is embedded in the VerbPhrases of the setbit, clearbit
+// and hasbit verbs, which concern one bit of an integer slot.  The bit
+// is named by the bit option, e.g.
+//
+//	SetVisible()  // defimpl:"setbit flags" bit:"FlagVisible"
+//
+// where FlagVisible is a constant of the package being processed.
+// The slot has the type of that constant, or int if it is untyped.
+type bitVerbPhrase struct {
+	flagVerbPhrase
+	bit string
+}
+
+// Bit returns the name of the constant for the bit.
+func (vp *bitVerbPhrase) Bit() string {
+	return vp.bit
+}
+
+// lookupConstant returns the constant with the specified name that is
+// declared at the top level of the package being processed, or nil.
+func lookupConstant(ctx *context, name string) *types.Const {
+	for _, file := range ctx.astFiles {
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				for _, id := range spec.(*ast.ValueSpec).Names {
+					if id.Name != name {
+						continue
+					}
+					if c, ok := ctx.info.Defs[id].(*types.Const); ok {
+						return c
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// newBitVerbPhrase is like newFlagVerbPhrase but for the bit verbs.
+func newBitVerbPhrase(ctx *context, vd VerbDefinition, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment, tmpl *template.Template, forms ...string) (bitVerbPhrase, error) {
+	fvp, err := newFlagVerbPhrase(ctx, vd, idef, field, comment, tmpl, forms...)
+	if err != nil {
+		return bitVerbPhrase{}, err
+	}
+	pos := ctx.fset.Position(comment.Slash)
+	bit, _ := reflect.StructTag(comment.Text[2:]).Lookup("bit")
+	if bit == "" {
+		return bitVerbPhrase{}, fmt.Errorf("defimpl: %s: verb %q requires the bit option",
+			pos, vd.Tag())
+	}
+	c := lookupConstant(ctx, bit)
+	if c == nil {
+		return bitVerbPhrase{}, fmt.Errorf("defimpl: %s: verb %q: no constant named %q",
+			pos, vd.Tag(), bit)
+	}
+	t := types.Default(c.Type())
+	if b, ok := t.Underlying().(*types.Basic); !ok || b.Info() & types.IsInteger == 0 {
+		return bitVerbPhrase{}, fmt.Errorf("defimpl: %s: verb %q: constant %s is not an integer",
+			pos, vd.Tag(), bit)
+	}
+	fvp.slot_type = t
+	return bitVerbPhrase{
+		flagVerbPhrase: fvp,
+		bit: bit,
+	}, nil
+}
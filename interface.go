@@ -9,11 +9,13 @@ import "strings"
 type InterfaceDefinition struct {
 	File          *File
 	IsAbstract    bool
+	Options       []InterfaceOption
 	InterfaceType *ast.InterfaceType
 	InterfaceName string
 	VerbPhrases   []VerbPhrase
 	Inherited     []*IDKey                // Interfaces that are included by this one
 	AllInherited  []*InterfaceDefinition  // Transitive closure of all inherited interfaces.
+	Visited       []*InterfaceDefinition  // For (VISITABLE), the interfaces of the family.
 }
 
 func (idef *InterfaceDefinition) QualifiedName() string {
@@ -40,11 +42,47 @@ func (idef *InterfaceDefinition) Package() string {
 	return idef.File.Package
 }
 
+// SlotSpecs returns the slotSpecs of the interface's impl struct in
+// the order that their slots are first mentioned.
+func (idef *InterfaceDefinition) SlotSpecs() []*slotSpec {
+	specs := []*slotSpec{}
+	for _, vp := range idef.VerbPhrases {
+		svp, ok := vp.(SlotVerbPhrase)
+		if !ok || svp.SlotSpec() == nil {
+			continue
+		}
+		found := false
+		for _, spec := range specs {
+			if spec == svp.SlotSpec() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			specs = append(specs, svp.SlotSpec())
+		}
+	}
+	return specs
+}
+
+// SlotIndex returns the position of the named slot in the result of
+// SlotSpecs, or -1 if there is no such slot.
+func (idef *InterfaceDefinition) SlotIndex(slot_name string) int {
+	for i, spec := range idef.SlotSpecs() {
+		if spec.SlotName() == slot_name {
+			return i
+		}
+	}
+	return -1
+}
+
 
 const InterfaceIsAbstractMarker string = "(ABSTRACT)"
 
 // isAbstractInterface returns true if the declaration -- which should
 // define an interface -- has a comment with the "abstract" token.
+// Unlike the markers of the InterfaceOptions, it can appear anywhere
+// in the comment.
 func isAbstractInterface(x *ast.GenDecl) bool {
 	var hasAbstract = func(cmnt *ast.CommentGroup) bool {
 		if cmnt == nil || cmnt.List == nil {
@@ -86,6 +124,7 @@ func NewInterface(ctx *context, file *File, decl ast.Decl) *InterfaceDefinition
 		// It appears that the parser associates the comment group with
 		// the outer GenDecl rather than with the TypeSpec.
 		IsAbstract:    isAbstractInterface(gd),
+		Options:       interfaceOptions(gd),
 		InterfaceType: it,
 		InterfaceName: spec.Name.Name,
 		Inherited:     []*IDKey{},
@@ -117,6 +156,13 @@ func TypePackage(t ast.Expr) string {
 			return tp(e.Elt, true)
 		case *ast.StarExpr:
 			return tp(e.X, true)
+		case *ast.IndexExpr:
+			// Instantiation of a generic type
+			return tp(e.X, top)
+		case *ast.IndexListExpr:
+			return tp(e.X, top)
+		case *ast.ChanType:
+			return tp(e.Value, true)
 		case *ast.FuncType:
 			// Unnamed function, so no package.
 			return ""
@@ -0,0 +1,133 @@
+package main
+
+import "bytes"
+import "go/ast"
+import "slices"
+import "sort"
+import "strings"
+import "text/template"
+
+
+// InterfaceOption is an option that applies to a whole interface
+// rather than to a single method.  An option is enabled by including
+// its marker, e.g. "(DIRTY)", in the comment on the interface's type
+// definition, the same way "(ABSTRACT)" is.
+type InterfaceOption interface {
+	// Marker returns the string that enables the option.
+	Marker() string
+	Description() string
+	// StructBody returns any fields that the option adds to the
+	// impl struct.
+	StructBody(*InterfaceDefinition) (string, error)
+	// GlobalsTemplate returns the template for the global
+	// definitions, if any, that the option contributes.  It is
+	// executed with the InterfaceDefinition.
+	GlobalsTemplate() *template.Template
+}
+
+var InterfaceOptions map[string]InterfaceOption = map[string]InterfaceOption{}
+
+
+// hasMarker returns true if the comment on the declaration has a line
+// that consists only of markers, one of which is marker, e.g.
+//
+//	// (DIRTY) (VIEW)
+//
+// A marker that is merely mentioned in the text of the comment doesn't
+// count.
+func hasMarker(x *ast.GenDecl, marker string) bool {
+	if x.Doc == nil {
+		return false
+	}
+	for _, line := range strings.Split(x.Doc.Text(), "\n") {
+		words := strings.Fields(line)
+		only_markers := len(words) > 0
+		for _, word := range words {
+			if !isMarker(word) {
+				only_markers = false
+			}
+		}
+		if only_markers && slices.Contains(words, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMarker returns true if word has the form of a marker: upper case
+// letters in parentheses.
+func isMarker(word string) bool {
+	if len(word) < 3 || word[0] != '(' || word[len(word) - 1] != ')' {
+		return false
+	}
+	for _, r := range word[1:len(word) - 1] {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// interfaceOptions returns the InterfaceOptions whose markers appear
+// in the comment of the declaration.
+func interfaceOptions(x *ast.GenDecl) []InterfaceOption {
+	options := []InterfaceOption{}
+	for _, opt := range InterfaceOptions {
+		if hasMarker(x, opt.Marker()) {
+			options = append(options, opt)
+		}
+	}
+	sort.Slice(options, func(i, j int) bool {
+		return options[i].Marker() < options[j].Marker()
+	})
+	return options
+}
+
+// AbstractOption can be implemented by an InterfaceOption whose
+// global definitions are wanted even for an interface that doesn't
+// define an impl struct, e.g. the root of a family of interfaces.
+type AbstractOption interface {
+	InterfaceOption
+	// AppliesToAbstract returns true if the option's global
+	// definitions should be generated for an interface that has no
+	// impl struct.
+	AppliesToAbstract() bool
+}
+
+// AbstractOptions returns those of the interface's options whose
+// global definitions are generated even though it defines no impl
+// struct.
+func (idef *InterfaceDefinition) AbstractOptions() []InterfaceOption {
+	options := []InterfaceOption{}
+	for _, opt := range idef.Options {
+		if ao, ok := opt.(AbstractOption); ok && ao.AppliesToAbstract() {
+			options = append(options, opt)
+		}
+	}
+	return options
+}
+
+// HasOption returns true if the option identified by marker is
+// enabled for the interface.
+func (idef *InterfaceDefinition) HasOption(marker string) bool {
+	for _, opt := range idef.Options {
+		if opt.Marker() == marker {
+			return true
+		}
+	}
+	return false
+}
+
+// OptionGlobalDefinitions returns the global definitions to be
+// generated for the InterfaceOption.
+func OptionGlobalDefinitions(opt InterfaceOption, idef *InterfaceDefinition) (string, error) {
+	tmpl := opt.GlobalsTemplate()
+	if tmpl == nil {
+		return "", nil
+	}
+	w := &bytes.Buffer{}
+	if err := tmpl.Execute(w, idef); err != nil {
+		return "", err
+	}
+	return w.String(), nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import "go/ast"
+import "go/parser"
+import "go/token"
+import "testing"
+
+
+func TestHasMarker(t *testing.T) {
+	src := `package p
+
+// A is used to test the (DIRTY) option.
+type A interface {}
+
+// B has a line of markers.
+// (DIRTY) (VIEW)
+type B interface {}
+
+// C has a marker that isn't alone on its line.
+// (DIRTY) options
+type C interface {}
+`
+	f, err := parser.ParseFile(token.NewFileSet(), "p.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := map[string]bool{ "A": false, "B": true, "C": false }
+	for _, decl := range f.Decls {
+		gd := decl.(*ast.GenDecl)
+		name := gd.Specs[0].(*ast.TypeSpec).Name.Name
+		if got := hasMarker(gd, "(DIRTY)"); got != expect[name] {
+			t.Errorf("%s: expected %v, got %v", name, expect[name], got)
+		}
+	}
+	if hasMarker(f.Decls[1].(*ast.GenDecl), "(JOURNAL)") {
+		t.Errorf("B: unexpected (JOURNAL)")
+	}
+}
+
+func TestAbstractMarker(t *testing.T) {
+	src := `package p
+
+// Base is the common part of shapes (ABSTRACT).
+type Base interface {}
+
+// Shape mentions (DIRTY) in passing.
+type Shape interface {}
+`
+	f, err := parser.ParseFile(token.NewFileSet(), "p.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isAbstractInterface(f.Decls[0].(*ast.GenDecl)) {
+		t.Errorf("Base: (ABSTRACT) can appear anywhere in the comment")
+	}
+	if hasMarker(f.Decls[1].(*ast.GenDecl), "(DIRTY)") {
+		t.Errorf("Shape: unexpected (DIRTY)")
+	}
+}
@@ -19,6 +19,10 @@ func main() {
 			fmt.Fprintf(os.Stderr, "%s\t  %s\n",
 				v.Tag(), v.Description())
 		}
+		for _, o := range InterfaceOptions {
+			fmt.Fprintf(os.Stderr, "%s\t  %s\n",
+				o.Marker(), o.Description())
+		}
 		return
 	}
 	afp, err := filepath.Abs(".")
@@ -35,6 +39,10 @@ func main() {
 		ctx.debug_dump()
 	}
 	ctx.DoInheritance()
+	if err := ctx.DoVisitors(); err != nil {
+		fmt.Fprintf(os.Stderr, "defimpl: %s\n", err)
+		return
+	}
 	for _, f := range ctx.files {
 		fmt.Printf("file %s\n", f.InputFilePath)
 		if err := f.Write(ctx); err != nil {
@@ -0,0 +1,92 @@
+package main
+
+import "strings"
+
+
+// MutationHook can be implemented by an InterfaceOption that needs to
+// take note whenever a generated method modifies a slot.
+//
+// The templates of verbs that modify a slot include
+// {{.BeforeMutation}} ahead of the code that modifies the slot and
+// {{.AfterMutation}} following it.
+type MutationHook interface {
+	// BeforeMutation returns code to be executed before the slot
+	// of svp is modified.
+	BeforeMutation(svp SlotVerbPhrase) string
+	// AfterMutation returns code to be executed after the slot of
+	// svp has been modified.
+	AfterMutation(svp SlotVerbPhrase) string
+}
+
+// mutationHooks returns the MutationHooks that apply to the
+// InterfaceDefinition.
+func mutationHooks(idef *InterfaceDefinition) []MutationHook {
+	hooks := []MutationHook{}
+	for _, opt := range idef.Options {
+		if hook, ok := opt.(MutationHook); ok {
+			hooks = append(hooks, hook)
+		}
+	}
+	return hooks
+}
+
+// BeforeMutation returns the code contributed by all applicable
+// MutationHooks to be executed before the slot is modified.
+func (svp *slotVerbPhrase) BeforeMutation() string {
+	return beforeMutation(svp.verbPhrase())
+}
+
+// AfterMutation returns the code contributed by all applicable
+// MutationHooks to be executed after the slot has been modified.  It
+// also invalidates any computed slots that depend on the slot, and,
+// for any verb but unset, records that the slot has been set, so that
+// the hooks see that too.
+func (svp *slotVerbPhrase) AfterMutation() string {
+	code := afterMutation(svp.verbPhrase())
+	if svp.Verb().Tag() == "unset" {
+		return code
+	}
+	if mark := svp.MarkPresent(); mark != "" {
+		return mark + "\n" + code
+	}
+	return code
+}
+
+func beforeMutation(svp SlotVerbPhrase) string {
+	code := []string{}
+	for _, hook := range mutationHooks(svp.InterfaceDefinition()) {
+		code = append(code, hook.BeforeMutation(svp))
+	}
+	return strings.Join(code, "\n")
+}
+
+func afterMutation(svp SlotVerbPhrase) string {
+	code := []string{}
+	for _, hook := range mutationHooks(svp.InterfaceDefinition()) {
+		code = append(code, hook.AfterMutation(svp))
+	}
+	if stale := invalidate(svp.InterfaceDefinition(), svp.SlotName()); stale != "" {
+		code = append(code, stale)
+	}
+	return strings.Join(code, "\n")
+}
+
+// verbPhrase returns the VerbPhrase that svp is embedded in, so that
+// MutationHooks can see the methods that it adds, e.g. those of
+// Invertible.
+func (svp *slotVerbPhrase) verbPhrase() SlotVerbPhrase {
+	for _, vp := range svp.SlotSpec().VerbPhrases {
+		if vp.Field() == svp.Field() {
+			return vp
+		}
+	}
+	return svp
+}
+
+// wholeSlot is for code, e.g. that of the (SNAPSHOT) option, that
+// replaces the entire value of a slot rather than applying a verb to
+// it.  Embedding the SlotVerbPhrase hides any methods of it that
+// SlotVerbPhrase doesn't have, e.g. those of Invertible.
+type wholeSlot struct {
+	SlotVerbPhrase
+}
@@ -0,0 +1,75 @@
+package main
+
+import "fmt"
+import "text/template"
+
+
+type Option_Dirty struct {}
+
+var _ InterfaceOption = (*Option_Dirty)(nil)
+var _ MutationHook = (*Option_Dirty)(nil)
+
+func init() {
+	opt := &Option_Dirty{}
+	InterfaceOptions[opt.Marker()] = opt
+}
+
+// Marker is part of the InterfaceOption interface.
+func (opt *Option_Dirty) Marker() string { return "(DIRTY)" }
+
+// Description is part of the InterfaceOption interface.
+func (opt *Option_Dirty) Description() string {
+	return "keeps track of which slots have been modified.  Defines DirtySlots and ClearDirty."
+}
+
+// StructBody is part of the InterfaceOption interface.
+func (opt *Option_Dirty) StructBody(idef *InterfaceDefinition) (string, error) {
+	return "\tdefimpl_dirty runtime.SlotSet\n", nil
+}
+
+// BeforeMutation is part of the MutationHook interface.
+func (opt *Option_Dirty) BeforeMutation(svp SlotVerbPhrase) string {
+	return ""
+}
+
+// AfterMutation is part of the MutationHook interface.
+func (opt *Option_Dirty) AfterMutation(svp SlotVerbPhrase) string {
+	return fmt.Sprintf("x.defimpl_dirty.Add(%d)",
+		svp.InterfaceDefinition().SlotIndex(svp.SlotName()))
+}
+
+var dirty_option_template = template.Must(
+	template.New("dirty_option_template").Parse(`
+// DirtySlots returns the names of the slots of {{.StructName}} that have been
+// modified since ClearDirty was last called.  defimpl option (DIRTY).
+func (x *{{.StructName}}) DirtySlots() []string {
+	{{- if .HasOption "(THREADSAFE)"}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	names := []string{ {{- range .SlotSpecs}}{{printf "%q" .SlotName}}, {{end -}} }
+	dirty := []string{}
+	for i, name := range names {
+		if x.defimpl_dirty.Contains(i) {
+			dirty = append(dirty, name)
+		}
+	}
+	return dirty
+}
+
+// ClearDirty marks all slots of {{.StructName}} as unmodified.  defimpl option (DIRTY).
+func (x *{{.StructName}}) ClearDirty() {
+	{{- if .HasOption "(THREADSAFE)"}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	x.defimpl_dirty.Clear()
+}
+
+var _ runtime.DirtyTracker = (*{{.StructName}})(nil)
+`))
+
+// GlobalsTemplate is part of the InterfaceOption interface.
+func (opt *Option_Dirty) GlobalsTemplate() *template.Template {
+	return dirty_option_template
+}
@@ -0,0 +1,126 @@
+package main
+
+import "fmt"
+import "strings"
+import "text/template"
+
+
+type Option_Journal struct {}
+
+var _ InterfaceOption = (*Option_Journal)(nil)
+var _ MutationHook = (*Option_Journal)(nil)
+
+func init() {
+	opt := &Option_Journal{}
+	InterfaceOptions[opt.Marker()] = opt
+}
+
+// Marker is part of the InterfaceOption interface.
+func (opt *Option_Journal) Marker() string { return "(JOURNAL)" }
+
+// Description is part of the InterfaceOption interface.
+func (opt *Option_Journal) Description() string {
+	return "records how to undo and redo each modification of a slot with the object's defimpl/runtime Journaler.  Defines SetJournaler."
+}
+
+// StructBody is part of the InterfaceOption interface.
+func (opt *Option_Journal) StructBody(idef *InterfaceDefinition) (string, error) {
+	return "\tdefimpl_journaler runtime.Journaler\n", nil
+}
+
+
+// Invertible can be implemented by the VerbPhrase of a verb that
+// modifies only part of a collection valued slot.  The (JOURNAL)
+// option then records how to reverse and reapply just that
+// modification, rather than copying the whole collection before and
+// after it.  The code that these methods return can refer to the
+// variables of the verb's method, which the recorded closures capture.
+type Invertible interface {
+	// JournalCapture returns code, executed before the slot is
+	// modified, that saves whatever JournalUndo and JournalRedo
+	// need that the modification would lose.
+	JournalCapture() string
+	// JournalUndo returns code that reverses the modification.
+	JournalUndo() string
+	// JournalRedo returns code that reapplies the modification.
+	JournalRedo() string
+}
+
+// invertible returns the Invertible of svp, if it has one and it
+// reverses everything that the modification changes.  It doesn't
+// save whether a slot that the presence verbs concern had been set.
+func invertible(svp SlotVerbPhrase) (Invertible, bool) {
+	if svp.SlotSpec().PresenceFlag() != "" {
+		return nil, false
+	}
+	inv, ok := svp.(Invertible)
+	return inv, ok
+}
+
+// Journaled returns true if the interface has the (JOURNAL) option.
+func (svp *slotVerbPhrase) Journaled() bool {
+	return svp.InterfaceDefinition().HasOption("(JOURNAL)")
+}
+
+// BeforeMutation is part of the MutationHook interface.
+func (opt *Option_Journal) BeforeMutation(svp SlotVerbPhrase) string {
+	if inv, ok := invertible(svp); ok {
+		return inv.JournalCapture()
+	}
+	return svp.SlotSpec().SaveState("x", "defimpl_undo")
+}
+
+// AfterMutation is part of the MutationHook interface.
+func (opt *Option_Journal) AfterMutation(svp SlotVerbPhrase) string {
+	// Undoing or redoing a modification is itself a modification
+	// that other MutationHooks, and the computed slots that depend on
+	// the slot, should know about.
+	others := []string{}
+	for _, hook := range mutationHooks(svp.InterfaceDefinition()) {
+		if hook != MutationHook(opt) {
+			others = append(others, hook.AfterMutation(svp))
+		}
+	}
+	if stale := invalidate(svp.InterfaceDefinition(), svp.SlotName()); stale != "" {
+		others = append(others, stale)
+	}
+	restore := func(code string) string {
+		body := []string{}
+		if svp.InterfaceDefinition().HasOption("(THREADSAFE)") {
+			body = append(body, "x.defimpl_mutex.Lock()",
+				"defer x.defimpl_mutex.Unlock()")
+		}
+		body = append(body, code)
+		body = append(body, others...)
+		return fmt.Sprintf("func() {\n%s\n}", strings.Join(body, "\n"))
+	}
+	if inv, ok := invertible(svp); ok {
+		return fmt.Sprintf("runtime.RecordMutation(x.defimpl_journaler, %s, %s)",
+			restore(inv.JournalUndo()), restore(inv.JournalRedo()))
+	}
+	spec := svp.SlotSpec()
+	return fmt.Sprintf("%s\nruntime.RecordMutation(x.defimpl_journaler, %s, %s)",
+		spec.SaveState("x", "defimpl_redo"),
+		restore(spec.RestoreState("x", "defimpl_undo")),
+		restore(spec.RestoreState("x", "defimpl_redo")))
+}
+
+var journal_option_template = template.Must(
+	template.New("journal_option_template").Parse(`
+// SetJournaler arranges for subsequent modifications of the
+// {{.StructName}} to be recorded in j.  defimpl option (JOURNAL).
+func (x *{{.StructName}}) SetJournaler(j runtime.Journaler) {
+	{{- if .HasOption "(THREADSAFE)"}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	x.defimpl_journaler = j
+}
+
+var _ runtime.Journaled = (*{{.StructName}})(nil)
+`))
+
+// GlobalsTemplate is part of the InterfaceOption interface.
+func (opt *Option_Journal) GlobalsTemplate() *template.Template {
+	return journal_option_template
+}
@@ -0,0 +1,97 @@
+package main
+
+import "text/template"
+
+
+type Option_Snapshot struct {}
+
+var _ InterfaceOption = (*Option_Snapshot)(nil)
+
+func init() {
+	opt := &Option_Snapshot{}
+	InterfaceOptions[opt.Marker()] = opt
+}
+
+// Marker is part of the InterfaceOption interface.
+func (opt *Option_Snapshot) Marker() string { return "(SNAPSHOT)" }
+
+// Description is part of the InterfaceOption interface.
+func (opt *Option_Snapshot) Description() string {
+	return "defines Snapshot and Restore methods to capture and later reinstate the values of all slots."
+}
+
+// StructBody is part of the InterfaceOption interface.
+func (opt *Option_Snapshot) StructBody(idef *InterfaceDefinition) (string, error) {
+	return "", nil
+}
+
+var snapshot_option_template = template.Must(
+	template.New("snapshot_option_template").Parse(`
+// defimpl_{{.StructName}}_snapshot holds the slot values captured by
+// (*{{.StructName}}).Snapshot.
+type defimpl_{{.StructName}}_snapshot struct {
+	{{- range .SlotSpecs}}
+	{{.SlotName}} {{.TypeString .SlotType}}
+	{{- with .InitializedFlag}}
+	{{.}} bool
+	{{- end}}
+	{{- with .PresenceFlag}}
+	{{.}} bool
+	{{- end}}
+	{{- end}}
+}
+
+// Snapshot returns an opaque value that captures the values of the
+// slots of {{.StructName}}.  Collection valued slots are copied.
+// defimpl option (SNAPSHOT).
+func (x *{{.StructName}}) Snapshot() any {
+	{{- if .HasOption "(THREADSAFE)"}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	return &defimpl_{{.StructName}}_snapshot{
+		{{- range .SlotSpecs}}
+		{{.SlotName}}: {{.Load "x"}},
+		{{- with .InitializedFlag}}
+		{{.}}: x.{{.}},
+		{{- end}}
+		{{- if .PresenceFlag}}
+		{{.PresenceFlag}}: {{.Presence "x"}},
+		{{- end}}
+		{{- end}}
+	}
+}
+
+// Restore sets the slots of {{.StructName}} to the values captured by
+// a previous call to Snapshot.  defimpl option (SNAPSHOT).
+func (x *{{.StructName}}) Restore(snapshot any) {
+	s, ok := snapshot.(*defimpl_{{.StructName}}_snapshot)
+	if !ok {
+		panic("(*{{.StructName}}).Restore: not a {{.StructName}} snapshot")
+	}
+	{{- if .HasOption "(THREADSAFE)"}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- range .SlotSpecs}}
+	{
+		{{.BeforeMutation}}
+		{{.Store "x" (print "s." .SlotName)}}
+		{{- with .InitializedFlag}}
+		x.{{.}} = s.{{.}}
+		{{- end}}
+		{{- if .PresenceFlag}}
+		{{.SetPresence "x" (print "s." .PresenceFlag)}}
+		{{- end}}
+		{{.AfterMutation}}
+	}
+	{{- end}}
+}
+
+var _ runtime.Snapshotter = (*{{.StructName}})(nil)
+`))
+
+// GlobalsTemplate is part of the InterfaceOption interface.
+func (opt *Option_Snapshot) GlobalsTemplate() *template.Template {
+	return snapshot_option_template
+}
@@ -0,0 +1,125 @@
+package main
+
+import "fmt"
+import "go/types"
+import "text/template"
+
+
+type Option_ThreadSafe struct {}
+
+var _ InterfaceOption = (*Option_ThreadSafe)(nil)
+
+func init() {
+	opt := &Option_ThreadSafe{}
+	InterfaceOptions[opt.Marker()] = opt
+}
+
+// Marker is part of the InterfaceOption interface.
+func (opt *Option_ThreadSafe) Marker() string { return "(THREADSAFE)" }
+
+// Description is part of the InterfaceOption interface.
+func (opt *Option_ThreadSafe) Description() string {
+	return "counter, swap and cas slots whose type sync/atomic supports are atomic.  Other verbs, and those that must keep other fields in step with an atomic slot, hold a mutex."
+}
+
+// StructBody is part of the InterfaceOption interface.
+func (opt *Option_ThreadSafe) StructBody(idef *InterfaceDefinition) (string, error) {
+	return "\tdefimpl_mutex sync.Mutex\n", nil
+}
+
+var threadsafe_option_template = template.Must(
+	template.New("threadsafe_option_template").Parse(``))
+
+// GlobalsTemplate is part of the InterfaceOption interface.
+func (opt *Option_ThreadSafe) GlobalsTemplate() *template.Template {
+	return threadsafe_option_template
+}
+
+
+// atomicTypes maps the numeric types that sync/atomic provides
+// atomic types for to the names of those types.
+var atomicTypes = map[types.BasicKind]string{
+	types.Int32: "Int32",
+	types.Int64: "Int64",
+	types.Uint32: "Uint32",
+	types.Uint64: "Uint64",
+	types.Uintptr: "Uintptr",
+}
+
+// atomicVerbs are the verbs whose slots are atomic if sync/atomic
+// supports their type.
+var atomicVerbs = append([]string{ "swap", "cas" }, counterVerbs...)
+
+// atomicTypeName returns the name of the sync/atomic type that is
+// used for the named slot of idef, whose type is t, or "" if the slot
+// isn't atomic.  Only the slots of atomicVerbs of interfaces with the
+// (THREADSAFE) option are atomic.  Named numeric types aren't, since
+// that would need conversions everywhere the slot is used.  Pointer
+// slots are an atomic.Pointer of the pointer's element type.
+func atomicTypeName(idef *InterfaceDefinition, slot string, t types.Type) string {
+	if !idef.HasOption("(THREADSAFE)") || !slotHasVerb(idef, slot, atomicVerbs...) {
+		return ""
+	}
+	switch t := t.(type) {
+	case *types.Basic:
+		return atomicTypes[t.Kind()]
+	case *types.Pointer:
+		return "Pointer"
+	}
+	return ""
+}
+
+// ThreadSafe returns true if the interface has the (THREADSAFE)
+// option.
+func (svp *slotVerbPhrase) ThreadSafe() bool {
+	return svp.InterfaceDefinition().HasOption("(THREADSAFE)")
+}
+
+// Atomic returns the name of the sync/atomic type of the slot, or "".
+func (svp *slotVerbPhrase) Atomic() string {
+	return atomicTypeName(svp.InterfaceDefinition(), svp.SlotName(),
+		svp.SlotSpec().SlotType())
+}
+
+// Locked returns true if the verb should hold the mutex of a
+// (THREADSAFE) implementation while it accesses the slot.  A verb of
+// an atomic slot still holds it if it must keep other fields in step
+// with the slot, since the atomic operation doesn't protect those:
+// the flag that records whether the slot has been initialized, or
+// what MutationHooks and computed slots maintain.
+func (svp *slotVerbPhrase) Locked() bool {
+	if !svp.ThreadSafe() {
+		return false
+	}
+	if svp.Atomic() == "" {
+		return true
+	}
+	idef := svp.InterfaceDefinition()
+	if slotInitialValue(idef, svp.SlotName()) != "" {
+		return true
+	}
+	return svp.Verb().Mutating() &&
+		(len(mutationHooks(idef)) > 0 || invalidate(idef, svp.SlotName()) != "")
+}
+
+// Elements returns the code with which a verb that calls a function
+// for each element of the slot declares elements, the slice or map to
+// range over.  A Locked verb ranges over a copy taken while holding
+// the mutex, so that the function can call other methods of the
+// object, which would otherwise deadlock.
+func (svp *slotVerbPhrase) Elements() string {
+	code := ""
+	if init := svp.Initialize(); init != "" {
+		code = init + "\n"
+	}
+	slot := "x." + svp.SlotName()
+	if !svp.Locked() {
+		return code + "elements := " + slot
+	}
+	clone := "slices.Clone"
+	if _, ok := svp.SlotSpec().SlotType().Underlying().(*types.Map); ok {
+		clone = "maps.Clone"
+	}
+	return fmt.Sprintf("x.defimpl_mutex.Lock()\n%selements := %s(%s)\nx.defimpl_mutex.Unlock()",
+		code, clone, slot)
+}
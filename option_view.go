@@ -0,0 +1,167 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "strings"
+import "text/template"
+import "defimpl/util"
+
+
+type Option_View struct {}
+
+var _ InterfaceOption = (*Option_View)(nil)
+
+func init() {
+	opt := &Option_View{}
+	InterfaceOptions[opt.Marker()] = opt
+}
+
+// Marker is part of the InterfaceOption interface.
+func (opt *Option_View) Marker() string { return "(VIEW)" }
+
+// Description is part of the InterfaceOption interface.
+func (opt *Option_View) Description() string {
+	return "defines a read-only view interface with only the methods whose verbs don't mutate, and a wrapper that implements it."
+}
+
+// StructBody is part of the InterfaceOption interface.
+func (opt *Option_View) StructBody(idef *InterfaceDefinition) (string, error) {
+	return "", nil
+}
+
+
+// viewMethod describes a method of the view interface.
+type viewMethod struct {
+	MethodName string
+	// Parameters is the parameter list, with parameter names.
+	Parameters string
+	// Arguments passes those parameters on.
+	Arguments string
+	Results string
+	// Clone, if not "", is the function, e.g. slices.Clone, that
+	// copies the result so that it can't be used to modify the
+	// object.
+	Clone string
+}
+
+// ViewName returns the name of the read-only view interface defined
+// for an interface with the (VIEW) option.
+func (idef *InterfaceDefinition) ViewName() string {
+	return idef.InterfaceName + "View"
+}
+
+// ViewMethods returns the methods that are included in the view
+// interface: those whose verbs are not Mutating.
+func (idef *InterfaceDefinition) ViewMethods() []*viewMethod {
+	methods := []*viewMethod{}
+	for _, vp := range idef.VerbPhrases {
+		if vp.Verb().Mutating() {
+			continue
+		}
+		field := vp.Field()
+		if len(field.Names) != 1 {
+			continue
+		}
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok {
+			continue
+		}
+		// A Field can declare more than one name.
+		count := func(f *ast.Field) int {
+			if len(f.Names) == 0 {
+				return 1
+			}
+			return len(f.Names)
+		}
+		params := []string{}
+		args := []string{}
+		for _, p := range util.FieldListSlice(ft.Params) {
+			for i := 0; i < count(p); i++ {
+				name := fmt.Sprintf("a%d", len(args))
+				params = append(params, name + " " + types.ExprString(p.Type))
+				if _, ok := p.Type.(*ast.Ellipsis); ok {
+					name += "..."
+				}
+				args = append(args, name)
+			}
+		}
+		results := []string{}
+		for _, r := range util.FieldListSlice(ft.Results) {
+			for i := 0; i < count(r); i++ {
+				results = append(results, types.ExprString(r.Type))
+			}
+		}
+		m := &viewMethod{
+			MethodName: vp.MethodName(),
+			Parameters: strings.Join(params, ", "),
+			Arguments: strings.Join(args, ", "),
+			Results: strings.Join(results, ", "),
+		}
+		if len(results) > 1 {
+			m.Results = "(" + m.Results + ")"
+		}
+		m.Clone = viewClone(vp)
+		methods = append(methods, m)
+	}
+	return methods
+}
+
+// viewClone returns the function that copies the result of the view
+// method for vp, or "".  The read verb returns the slot itself, so a
+// slice or map valued slot would otherwise be exposed to modification,
+// unless the copy option already makes read return a copy.  The copy
+// is shallow.
+func viewClone(vp VerbPhrase) string {
+	svp, ok := vp.(SlotVerbPhrase)
+	if !ok || vp.Verb().Tag() != "read" || slotOption(vp.InterfaceDefinition(), svp.SlotName(), "copy") == "true" {
+		return ""
+	}
+	switch svp.SlotType().Underlying().(type) {
+	case *types.Slice:
+		return "slices.Clone"
+	case *types.Map:
+		return "maps.Clone"
+	}
+	return ""
+}
+
+var view_option_template = template.Must(
+	template.New("view_option_template").Parse(`
+// {{.ViewName}} is the read-only subset of the {{.InterfaceName}} interface.
+// defimpl option (VIEW).
+type {{.ViewName}} interface {
+	{{- range .ViewMethods}}
+	{{.MethodName}}({{.Parameters}}) {{.Results}}
+	{{- end}}
+}
+
+var _ {{.ViewName}} = {{.InterfaceName}}(nil)
+
+// {{.ViewName}}Wrapper implements {{.ViewName}} for any {{.InterfaceName}}
+// without exposing the {{.InterfaceName}}'s other methods.
+// defimpl option (VIEW).
+type {{.ViewName}}Wrapper struct {
+	wrapped {{.InterfaceName}}
+}
+
+// New{{.ViewName}} returns a {{.ViewName}} of x.
+func New{{.ViewName}}(x {{.InterfaceName}}) {{.ViewName}} {
+	return &{{.ViewName}}Wrapper{ wrapped: x }
+}
+{{range .ViewMethods}}
+// {{.MethodName}} is part of the {{$.ViewName}} interface.  defimpl option (VIEW).
+func (v *{{$.ViewName}}Wrapper) {{.MethodName}}({{.Parameters}}) {{.Results}} {
+	{{- if .Clone}}
+	return {{.Clone}}(v.wrapped.{{.MethodName}}({{.Arguments}}))
+	{{- else}}
+	{{if .Results}}return {{end}}v.wrapped.{{.MethodName}}({{.Arguments}})
+	{{- end}}
+}
+{{end}}
+`))
+
+// GlobalsTemplate is part of the InterfaceOption interface.
+func (opt *Option_View) GlobalsTemplate() *template.Template {
+	return view_option_template
+}
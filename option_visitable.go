@@ -0,0 +1,158 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "text/template"
+
+
+type Option_Visitable struct {}
+
+var _ InterfaceOption = (*Option_Visitable)(nil)
+var _ AbstractOption = (*Option_Visitable)(nil)
+
+func init() {
+	opt := &Option_Visitable{}
+	InterfaceOptions[opt.Marker()] = opt
+}
+
+// Marker is part of the InterfaceOption interface.
+func (opt *Option_Visitable) Marker() string { return "(VISITABLE)" }
+
+// Description is part of the InterfaceOption interface.
+func (opt *Option_Visitable) Description() string {
+	return "defines a visitor interface with a Visit method for each interface of the package that embeds this one, a BaseVisitor that does nothing, and Accept methods for their impl structs."
+}
+
+// StructBody is part of the InterfaceOption interface.
+func (opt *Option_Visitable) StructBody(idef *InterfaceDefinition) (string, error) {
+	return "", nil
+}
+
+// AppliesToAbstract is part of the AbstractOption interface.  The
+// root of a family of interfaces is often (ABSTRACT).
+func (opt *Option_Visitable) AppliesToAbstract() bool { return true }
+
+
+// DoVisitors fills in the Visited field of each InterfaceDefinition
+// with the (VISITABLE) option while a context is readily available.
+// An impl struct can only accept one kind of visitor, so it is an
+// error for an interface to be in the families of two of them.
+func (ctx *context) DoVisitors() error {
+	families := map[*InterfaceDefinition]*InterfaceDefinition{}
+	for _, f := range ctx.files {
+		for _, idef := range f.Interfaces {
+			if !idef.HasOption("(VISITABLE)") {
+				continue
+			}
+			idef.Visited = ctx.visitableFamily(idef)
+			for _, member := range idef.Visited {
+				if root, ok := families[member]; ok {
+					return fmt.Errorf("%s is in the families of both (VISITABLE) interfaces %s and %s, so %s would have two Accept methods",
+						member.InterfaceName, root.InterfaceName, idef.InterfaceName, member.StructName())
+				}
+				families[member] = idef
+			}
+		}
+	}
+	return nil
+}
+
+// visitableFamily returns root and those interfaces of the package
+// that embed it, directly or through other interfaces, that have an
+// impl struct to accept a visitor.
+func (ctx *context) visitableFamily(root *InterfaceDefinition) []*InterfaceDefinition {
+	family := []*InterfaceDefinition{}
+	for _, f := range ctx.files {
+		for _, idef := range f.Interfaces {
+			if !idef.DefinesStruct() {
+				continue
+			}
+			if idef == root || ctx.embeds(idef, root.InterfaceName, nil) {
+				family = append(family, idef)
+			}
+		}
+	}
+	return family
+}
+
+// embeds returns true if idef embeds the interface named name,
+// either directly or through some other interface of the package.
+func (ctx *context) embeds(idef *InterfaceDefinition, name string, seen []*InterfaceDefinition) bool {
+	for _, s := range seen {
+		if s == idef {
+			return false
+		}
+	}
+	seen = append(seen, idef)
+	for _, field := range idef.Fields() {
+		if len(field.Names) != 0 {
+			continue
+		}
+		id, ok := field.Type.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		if id.Name == name {
+			return true
+		}
+		if embedded := ctx.interfaceNamed(id.Name); embedded != nil &&
+			ctx.embeds(embedded, name, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// interfaceNamed returns the InterfaceDefinition of the package's
+// interface with the given name, or nil.
+func (ctx *context) interfaceNamed(name string) *InterfaceDefinition {
+	for _, f := range ctx.files {
+		for _, idef := range f.Interfaces {
+			if idef.InterfaceName == name {
+				return idef
+			}
+		}
+	}
+	return nil
+}
+
+// VisitorName returns the name of the visitor interface defined for an
+// interface with the (VISITABLE) option.
+func (idef *InterfaceDefinition) VisitorName() string {
+	return idef.InterfaceName + "Visitor"
+}
+
+var visitable_option_template = template.Must(
+	template.New("visitable_option_template").Parse(`
+// {{.VisitorName}} has a method for each interface of the
+// {{.InterfaceName}} family.  The Accept method of each member calls
+// the one for its own interface.
+// defimpl option (VISITABLE).
+type {{.VisitorName}} interface {
+	{{- range .Visited}}
+	Visit{{.InterfaceName}}({{.InterfaceName}})
+	{{- end}}
+}
+
+// Base{{.VisitorName}} implements {{.VisitorName}} with methods that
+// do nothing.  Embed it in a visitor that only cares about some
+// members of the family.
+// defimpl option (VISITABLE).
+type Base{{.VisitorName}} struct {}
+
+var _ {{.VisitorName}} = Base{{.VisitorName}}{}
+{{range .Visited}}
+// Visit{{.InterfaceName}} is part of the {{$.VisitorName}} interface.  defimpl option (VISITABLE).
+func (Base{{$.VisitorName}}) Visit{{.InterfaceName}}({{.InterfaceName}}) {}
+
+// Accept calls the Visit{{.InterfaceName}} method of v.  defimpl option (VISITABLE).
+func (x *{{.StructName}}) Accept(v {{$.VisitorName}}) {
+	v.Visit{{.InterfaceName}}(x)
+}
+{{end}}
+`))
+
+// GlobalsTemplate is part of the InterfaceOption interface.
+func (opt *Option_Visitable) GlobalsTemplate() *template.Template {
+	return visitable_option_template
+}
@@ -0,0 +1,41 @@
+package main
+
+import "os"
+import "path/filepath"
+import "strings"
+import "testing"
+
+
+func TestVisitableOverlap(t *testing.T) {
+	src := `package p
+
+// (VISITABLE)
+type Shape interface {
+	Accept(ShapeVisitor)
+}
+
+// (VISITABLE)
+type Named interface {
+	Accept(NamedVisitor)
+}
+
+type Circle interface {
+	Shape
+	Named
+	Radius() int // defimpl:"read radius"
+}
+`
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	ctx, err := NewContext(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx.DoInheritance()
+	err = ctx.DoVisitors()
+	if err == nil || !strings.Contains(err.Error(), "Circle is in the families of both") {
+		t.Errorf("expected an error about Circle, got %v", err)
+	}
+}
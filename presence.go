@@ -0,0 +1,126 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "text/template"
+
+
+// presenceVerbs are the verbs that distinguish a slot that has never
+// been set from one that has been set to its zero value.  The slots
+// they concern are tracked by a runtime.SlotSet in the struct.
+var presenceVerbs = []string{ "has", "isset", "unset" }
+
+// presenceField is the name of that SlotSet.
+const presenceField = "defimpl_present"
+
+// newPresenceVerbPhrase does the work that is common to the
+// NewVerbPhrase methods of the presence verbs.  tmpl is the template
+// to check the method signature against.  It doesn't mention the slot
+// type, which must be determined by some other verb, e.g. set.
+func newPresenceVerbPhrase(ctx *context, vd VerbDefinition, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment, tmpl *template.Template) (slotVerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return slotVerbPhrase{}, err
+	}
+	if _, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, tmpl); err != nil {
+		return slotVerbPhrase{}, err
+	}
+	return slotVerbPhrase {
+		baseVerbPhrase: baseVerbPhrase {
+			verb: vd,
+			idef: idef,
+			field: field,
+		},
+		slot_name: slot,
+	}, nil
+}
+
+// tracksPresence returns true if the named slot of idef is tracked
+// by the presence SlotSet.
+func tracksPresence(idef *InterfaceDefinition, slot string) bool {
+	return slotHasVerb(idef, slot, presenceVerbs...)
+}
+
+// presenceStructBody returns the declaration of the presence SlotSet
+// if slot is the first slot of idef that is tracked by it, so that it
+// is declared only once.
+func presenceStructBody(idef *InterfaceDefinition, slot string) string {
+	for _, spec := range idef.SlotSpecs() {
+		if tracksPresence(idef, spec.SlotName()) {
+			if spec.SlotName() == slot {
+				return fmt.Sprintf("\t%s runtime.SlotSet\n", presenceField)
+			}
+			break
+		}
+	}
+	return ""
+}
+
+// Present returns an expression that is true if the slot has been
+// set.
+func (svp *slotVerbPhrase) Present() string {
+	return fmt.Sprintf("x.%s.Contains(%d)", presenceField,
+		svp.InterfaceDefinition().SlotIndex(svp.SlotName()))
+}
+
+// Reset returns the code that gives the slot the zero value of its
+// type.
+func (svp *slotVerbPhrase) Reset() string {
+	slot := "x." + svp.SlotName()
+	switch svp.Atomic() {
+	case "":
+	case "Pointer":
+		return slot + ".Store(nil)"
+	default:
+		return slot + ".Store(0)"
+	}
+	return fmt.Sprintf("var zero %s\n%s = zero",
+		svp.TypeString(svp.SlotSpec().SlotType()), slot)
+}
+
+// MarkPresent returns the code that records that the slot has been
+// set, or "" if none of the presence verbs concern the slot.
+func (svp *slotVerbPhrase) MarkPresent() string {
+	if !tracksPresence(svp.InterfaceDefinition(), svp.SlotName()) {
+		return ""
+	}
+	return fmt.Sprintf("x.%s.Add(%d)", presenceField,
+		svp.InterfaceDefinition().SlotIndex(svp.SlotName()))
+}
+
+// MarkAbsent returns the code that records that the slot has been
+// unset.  The initial value given by the default or init option, if
+// any, will be reinstated the next time the slot is used.
+func (svp *slotVerbPhrase) MarkAbsent() string {
+	code := fmt.Sprintf("x.%s.Remove(%d)", presenceField,
+		svp.InterfaceDefinition().SlotIndex(svp.SlotName()))
+	if slotInitialValue(svp.InterfaceDefinition(), svp.SlotName()) != "" {
+		code += fmt.Sprintf("\nx.%s = false", initializedFlag(svp.SlotName()))
+	}
+	return code
+}
+
+// PresenceFlag returns the name under which the (SNAPSHOT) and
+// (JOURNAL) options save whether the slot has been set, or "" if none
+// of the presence verbs concern the slot.
+func (spec *slotSpec) PresenceFlag() string {
+	if !tracksPresence(spec.InterfaceDefinition(), spec.SlotName()) {
+		return ""
+	}
+	return "defimpl_present_" + spec.SlotName()
+}
+
+// Presence returns an expression that is true if the slot of x has
+// been set.
+func (spec *slotSpec) Presence(x string) string {
+	return fmt.Sprintf("%s.%s.Contains(%d)", x, presenceField,
+		spec.InterfaceDefinition().SlotIndex(spec.SlotName()))
+}
+
+// SetPresence returns the code that records whether the slot of x has
+// been set according to the bool expression present.
+func (spec *slotSpec) SetPresence(x, present string) string {
+	i := spec.InterfaceDefinition().SlotIndex(spec.SlotName())
+	return fmt.Sprintf("if %s {\n%s.%s.Add(%d)\n} else {\n%s.%s.Remove(%d)\n}",
+		present, x, presenceField, i, x, presenceField, i)
+}
@@ -0,0 +1,42 @@
+package runtime
+
+import "hash/maphash"
+
+
+// hashSeed is shared by every Hash so that equal values have equal
+// hashes throughout the process.
+var hashSeed = maphash.MakeSeed()
+
+// Hash accumulates a hash of a sequence of values.  Code generated by
+// defimpl for the hash verb uses it.  Hashes are only consistent
+// within a process.
+type Hash struct {
+	h maphash.Hash
+}
+
+// NewHash returns an empty Hash.
+func NewHash() *Hash {
+	h := &Hash{}
+	h.h.SetSeed(hashSeed)
+	return h
+}
+
+// WriteUint64 adds v to the hash.
+func (h *Hash) WriteUint64(v uint64) {
+	var b [8]byte
+	for i := range b {
+		b[i] = byte(v >> (8 * i))
+	}
+	h.h.Write(b[:])
+}
+
+// Sum64 returns the hash of the values added so far.
+func (h *Hash) Sum64() uint64 {
+	return h.h.Sum64()
+}
+
+// HashComparable adds v to h.  Values that are == have the same hash,
+// so pointers and interfaces holding pointers are hashed by identity.
+func HashComparable[T comparable](h *Hash, v T) {
+	maphash.WriteComparable(&h.h, v)
+}
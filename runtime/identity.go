@@ -0,0 +1,87 @@
+package runtime
+
+import goruntime "runtime"
+import "sync"
+import "sync/atomic"
+import "weak"
+
+
+// lastID is the most recently assigned object identifier.
+var lastID atomic.Uint64
+
+// NewID returns an identifier that is unique within the process.  It
+// is never zero.  Code generated by defimpl for the id verb uses it.
+func NewID() uint64 {
+	return lastID.Add(1)
+}
+
+
+// registry maps object identifiers to the objects, for debugging and
+// for resolving references when deserializing.  It is only populated
+// while enabled.  It holds only weak references so it doesn't keep
+// objects from being garbage collected.
+var registry struct {
+	sync.Mutex
+	// enabled is atomic so that RegisterObject is cheap while the
+	// registry is off.
+	enabled atomic.Bool
+	objects map[uint64]func() any
+}
+
+// EnableRegistry turns the identifier registry on or off.  It returns
+// whether it was previously enabled.
+//
+// Objects are registered whenever their ID method is called while the
+// registry is on.  Identifiers are assigned when first asked for, so an
+// object can only be found once its ID method has been called with the
+// registry on, even if it was assigned its identifier while the
+// registry was off.
+func EnableRegistry(enable bool) bool {
+	return registry.enabled.Swap(enable)
+}
+
+// RegisterObject records that the object p has identifier id if the
+// registry is enabled and it isn't already recorded.
+//
+// RegisterObject should only be called from code generated by defimpl.
+func RegisterObject[T any](id uint64, p *T) {
+	if !registry.enabled.Load() {
+		return
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.objects[id]; ok {
+		return
+	}
+	if registry.objects == nil {
+		registry.objects = map[uint64]func() any{}
+	}
+	wp := weak.Make(p)
+	registry.objects[id] = func() any {
+		if p := wp.Value(); p != nil {
+			return p
+		}
+		return nil
+	}
+	goruntime.AddCleanup(p, forgetID, id)
+}
+
+// forgetID removes id from the registry once its object has been
+// garbage collected.
+func forgetID(id uint64) {
+	registry.Lock()
+	defer registry.Unlock()
+	delete(registry.objects, id)
+}
+
+// LookupID returns the object with the specified identifier, or nil if
+// it wasn't registered or has been garbage collected.
+func LookupID(id uint64) any {
+	registry.Lock()
+	get, ok := registry.objects[id]
+	registry.Unlock()
+	if !ok {
+		return nil
+	}
+	return get()
+}
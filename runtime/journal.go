@@ -0,0 +1,173 @@
+package runtime
+
+import "sync"
+
+
+// Journaler is the interface through which code generated by defimpl
+// for interfaces with the (JOURNAL) option records each modification
+// of a slot.  undo reverses the modification and redo reapplies it.
+type Journaler interface {
+	Record(undo, redo func())
+}
+
+// Journaled is implemented by the impl structs of interfaces that have
+// the (JOURNAL) option.  Each object records its modifications with
+// its own Journaler, so that unrelated objects needn't share a
+// history.
+type Journaled interface {
+	// SetJournaler arranges for subsequent modifications of the
+	// object to be recorded in j.  j can be nil, in which case
+	// modifications are not recorded.
+	SetJournaler(j Journaler)
+}
+
+// RecordMutation should only be called from code generated by defimpl.
+// It records a modification with j, if it isn't nil.
+func RecordMutation(j Journaler, undo, redo func()) {
+	if j != nil {
+		j.Record(undo, redo)
+	}
+}
+
+
+type journalEntry struct {
+	undo func()
+	redo func()
+}
+
+// journalGroup is a sequence of modifications that are undone or
+// redone together.
+type journalGroup []journalEntry
+
+
+// Journal is a Journaler that supports undoing and redoing groups of
+// modifications.  Modifications that are recorded between calls to
+// Begin and Commit form a single group, no matter how many objects
+// they affect.  A modification that is recorded outside of Begin and
+// Commit forms a group by itself.  The zero value is an empty Journal.
+type Journal struct {
+	// replaying serializes Undo and Redo.  mutex isn't held while
+	// they undo or redo modifications, since doing so takes the
+	// locks of (THREADSAFE) objects, which hold those locks while
+	// they record modifications.
+	replaying sync.Mutex
+	mutex sync.Mutex
+	depth int
+	open journalGroup
+	undo []journalGroup
+	redo []journalGroup
+}
+
+var _ Journaler = (*Journal)(nil)
+
+// Begin starts a group of modifications.  Calls to Begin and Commit
+// can nest, in which case the outermost pair delimits the group.
+func (j *Journal) Begin() {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	j.depth += 1
+}
+
+// Commit ends the group of modifications started by the corresponding
+// call to Begin.
+func (j *Journal) Commit() {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	if j.depth == 0 {
+		panic("defimpl/runtime: (*Journal).Commit called without Begin")
+	}
+	j.depth -= 1
+	if j.depth == 0 {
+		j.push(j.open)
+		j.open = nil
+	}
+}
+
+// push adds a completed group to the undo stack.  Since the group
+// represents new modifications, nothing can be redone.
+func (j *Journal) push(group journalGroup) {
+	if len(group) == 0 {
+		return
+	}
+	j.undo = append(j.undo, group)
+	j.redo = nil
+}
+
+// Record is part of the Journaler interface.
+func (j *Journal) Record(undo, redo func()) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	entry := journalEntry{ undo: undo, redo: redo }
+	if j.depth > 0 {
+		j.open = append(j.open, entry)
+	} else {
+		j.push(journalGroup{ entry })
+	}
+}
+
+// CanUndo returns true if there is a group of modifications to undo.
+func (j *Journal) CanUndo() bool {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	return len(j.undo) > 0
+}
+
+// CanRedo returns true if there is a group of modifications to redo.
+func (j *Journal) CanRedo() bool {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	return len(j.redo) > 0
+}
+
+// Undo reverses the most recent group of modifications.  It returns
+// false if there was nothing to undo.
+func (j *Journal) Undo() bool {
+	j.replaying.Lock()
+	defer j.replaying.Unlock()
+	group := j.pop(&j.undo, "Undo")
+	if group == nil {
+		return false
+	}
+	for i := len(group) - 1; i >= 0; i-- {
+		group[i].undo()
+	}
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	j.redo = append(j.redo, group)
+	return true
+}
+
+// Redo reapplies the most recently undone group of modifications.  It
+// returns false if there was nothing to redo.
+func (j *Journal) Redo() bool {
+	j.replaying.Lock()
+	defer j.replaying.Unlock()
+	group := j.pop(&j.redo, "Redo")
+	if group == nil {
+		return false
+	}
+	for _, entry := range group {
+		entry.redo()
+	}
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	j.undo = append(j.undo, group)
+	return true
+}
+
+// pop removes and returns the last group of stack, or returns nil if
+// it is empty.  caller is the name of the method that calls pop, for
+// the panic if a group is open.
+func (j *Journal) pop(stack *[]journalGroup, caller string) journalGroup {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	if j.depth > 0 {
+		panic("defimpl/runtime: (*Journal)." + caller + " called between Begin and Commit")
+	}
+	if len(*stack) == 0 {
+		return nil
+	}
+	group := (*stack)[len(*stack) - 1]
+	*stack = (*stack)[:len(*stack) - 1]
+	return group
+}
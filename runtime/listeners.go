@@ -0,0 +1,63 @@
+package runtime
+
+import "slices"
+import "sync/atomic"
+
+
+// Listeners is a list of event handlers, functions of type F.  Code
+// generated by defimpl uses it for the slots of the listen and fire
+// verbs.  The zero value is an empty Listeners.
+type Listeners[F any] struct {
+	handlers []*Handler[F]
+}
+
+// Handler is an event handler that has been added to a Listeners.
+type Handler[F any] struct {
+	F F
+	removed atomic.Bool
+}
+
+// Removed returns true if the handler has been removed from the
+// Listeners it was added to.  A handler that is removed while an event
+// is being fired shouldn't be called for that event.
+func (h *Handler[F]) Removed() bool {
+	return h.removed.Load()
+}
+
+// Len returns the number of handlers.
+func (l *Listeners[F]) Len() int {
+	return len(l.handlers)
+}
+
+// Add adds f to the handlers.
+func (l *Listeners[F]) Add(f F) *Handler[F] {
+	h := &Handler[F]{ F: f }
+	l.handlers = append(l.handlers, h)
+	return h
+}
+
+// Remove removes h from the handlers.  It does nothing if h has
+// already been removed.
+func (l *Listeners[F]) Remove(h *Handler[F]) {
+	if h.removed.Swap(true) {
+		return
+	}
+	// Don't modify the slice in place since Handlers might have
+	// returned it.
+	l.handlers = slices.DeleteFunc(slices.Clone(l.handlers),
+		func(h1 *Handler[F]) bool { return h1 == h })
+}
+
+// Handlers returns the handlers, in the order that they were added.
+// Handlers that are added or removed afterwards don't affect the
+// result, so an event can be fired by calling each handler that hasn't
+// been Removed.
+func (l *Listeners[F]) Handlers() []*Handler[F] {
+	return l.handlers
+}
+
+// Clone returns a copy of the Listeners that doesn't share storage
+// with it.
+func (l *Listeners[F]) Clone() Listeners[F] {
+	return Listeners[F]{ handlers: slices.Clone(l.handlers) }
+}
@@ -0,0 +1,93 @@
+package runtime
+
+import "slices"
+
+
+// OrderedSet is a set that remembers the order in which its members
+// were added.  Code generated by defimpl uses it for the slots of set
+// verbs that have the ordered:"true" option.  The zero value is an
+// empty OrderedSet.
+type OrderedSet[T comparable] struct {
+	// index maps each member to its position in members.
+	index map[T]int
+	members []T
+}
+
+// Add adds v to the set if it isn't already a member.  It returns
+// true if v was added.
+func (s *OrderedSet[T]) Add(v T) bool {
+	if _, ok := s.index[v]; ok {
+		return false
+	}
+	if s.index == nil {
+		s.index = map[T]int{}
+	}
+	s.index[v] = len(s.members)
+	s.members = append(s.members, v)
+	return true
+}
+
+// Remove removes v from the set.  It returns true if v had been a
+// member.
+func (s *OrderedSet[T]) Remove(v T) bool {
+	i, ok := s.index[v]
+	if !ok {
+		return false
+	}
+	delete(s.index, v)
+	s.members = append(s.members[:i], s.members[i+1:]...)
+	for j := i; j < len(s.members); j++ {
+		s.index[s.members[j]] = j
+	}
+	return true
+}
+
+// Index returns the position of v in the order in which members were
+// added, and true, or false if v isn't a member.
+func (s *OrderedSet[T]) Index(v T) (int, bool) {
+	i, ok := s.index[v]
+	return i, ok
+}
+
+// Insert adds v to the set at position i of the order, if it isn't
+// already a member.  It returns true if v was added.  Code generated
+// for the (JOURNAL) option uses it to undo Remove.
+func (s *OrderedSet[T]) Insert(i int, v T) bool {
+	if _, ok := s.index[v]; ok {
+		return false
+	}
+	if s.index == nil {
+		s.index = map[T]int{}
+	}
+	s.members = slices.Insert(s.members, i, v)
+	for j := i; j < len(s.members); j++ {
+		s.index[s.members[j]] = j
+	}
+	return true
+}
+
+// Contains returns true if v is a member of the set.
+func (s *OrderedSet[T]) Contains(v T) bool {
+	_, ok := s.index[v]
+	return ok
+}
+
+// Len returns the number of members of the set.
+func (s *OrderedSet[T]) Len() int {
+	return len(s.members)
+}
+
+// Members returns the members of the set in the order they were
+// added.  The result doesn't share storage with the set.
+func (s *OrderedSet[T]) Members() []T {
+	return append([]T(nil), s.members...)
+}
+
+// Clone returns a copy of the set that doesn't share storage with it.
+func (s *OrderedSet[T]) Clone() OrderedSet[T] {
+	c := OrderedSet[T]{}
+	for _, v := range s.members {
+		c.Add(v)
+	}
+	return c
+}
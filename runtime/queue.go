@@ -0,0 +1,124 @@
+package runtime
+
+
+// Queue is a ring buffer of elements of type T.  Code generated by
+// defimpl uses it for the slots of the enqueue and dequeue verbs so
+// that removing the element at the front of the queue doesn't require
+// reslicing.  The zero value is an empty Queue.
+type Queue[T any] struct {
+	elements []T
+	// head is the index in elements of the front of the queue.
+	head int
+	count int
+}
+
+// Len returns the number of elements in the queue.
+func (q *Queue[T]) Len() int {
+	return q.count
+}
+
+// At returns the element at position i from the front of the queue.
+func (q *Queue[T]) At(i int) T {
+	if i < 0 || i >= q.count {
+		panic("defimpl/runtime: Queue index out of range")
+	}
+	return q.elements[(q.head + i) % len(q.elements)]
+}
+
+// grow makes room for at least one more element.
+func (q *Queue[T]) grow() {
+	if q.count < len(q.elements) {
+		return
+	}
+	size := 2 * len(q.elements)
+	if size == 0 {
+		size = 4
+	}
+	elements := make([]T, size)
+	for i := 0; i < q.count; i++ {
+		elements[i] = q.At(i)
+	}
+	q.elements = elements
+	q.head = 0
+}
+
+// PushBack adds v to the back of the queue.
+func (q *Queue[T]) PushBack(v T) {
+	q.grow()
+	q.elements[(q.head + q.count) % len(q.elements)] = v
+	q.count += 1
+}
+
+// PushFront adds v to the front of the queue.  Code generated for the
+// (JOURNAL) option uses it to undo PopFront.
+func (q *Queue[T]) PushFront(v T) {
+	q.grow()
+	q.head = (q.head + len(q.elements) - 1) % len(q.elements)
+	q.elements[q.head] = v
+	q.count += 1
+}
+
+// Front returns the element at the front of the queue and true, or the
+// zero value and false if the queue is empty.
+func (q *Queue[T]) Front() (T, bool) {
+	if q.count == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.elements[q.head], true
+}
+
+// Back returns the element at the back of the queue and true, or the
+// zero value and false if the queue is empty.
+func (q *Queue[T]) Back() (T, bool) {
+	if q.count == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.At(q.count - 1), true
+}
+
+// PopFront removes and returns the element at the front of the queue.
+// It returns false if the queue is empty.
+func (q *Queue[T]) PopFront() (T, bool) {
+	v, ok := q.Front()
+	if ok {
+		var zero T
+		// Don't retain a reference to the removed element.
+		q.elements[q.head] = zero
+		q.head = (q.head + 1) % len(q.elements)
+		q.count -= 1
+	}
+	return v, ok
+}
+
+// PopBack removes and returns the element at the back of the queue.
+// It returns false if the queue is empty.
+func (q *Queue[T]) PopBack() (T, bool) {
+	v, ok := q.Back()
+	if ok {
+		var zero T
+		q.elements[(q.head + q.count - 1) % len(q.elements)] = zero
+		q.count -= 1
+	}
+	return v, ok
+}
+
+// Elements returns the elements of the queue from front to back.  The
+// result doesn't share storage with the queue.
+func (q *Queue[T]) Elements() []T {
+	elements := make([]T, q.count)
+	for i := range elements {
+		elements[i] = q.At(i)
+	}
+	return elements
+}
+
+// Clone returns a copy of the queue that doesn't share storage with it.
+func (q *Queue[T]) Clone() Queue[T] {
+	c := Queue[T]{}
+	for i := 0; i < q.count; i++ {
+		c.PushBack(q.At(i))
+	}
+	return c
+}
@@ -107,3 +107,23 @@ func Dump() {
 	}
 }
 
+
+// DirtyTracker is implemented by the impl structs of interfaces that
+// have the (DIRTY) option.
+type DirtyTracker interface {
+	// DirtySlots returns the names of the slots that have been
+	// modified since ClearDirty was last called.
+	DirtySlots() []string
+	// ClearDirty marks all slots as unmodified.
+	ClearDirty()
+}
+
+// Snapshotter is implemented by the impl structs of interfaces that
+// have the (SNAPSHOT) option.
+type Snapshotter interface {
+	// Snapshot returns an opaque value that captures the values
+	// of all slots.
+	Snapshot() any
+	// Restore reinstates the slot values captured by Snapshot.
+	Restore(snapshot any)
+}
@@ -0,0 +1,38 @@
+package runtime
+
+
+// SlotSet is a set of slot indices.  Code generated by defimpl uses
+// it to keep track of per slot state, e.g. which slots have been
+// modified.  The zero value is an empty SlotSet.
+type SlotSet struct {
+	bits []uint64
+}
+
+// Add adds slot index i to the set.
+func (s *SlotSet) Add(i int) {
+	for len(s.bits) <= i / 64 {
+		s.bits = append(s.bits, 0)
+	}
+	s.bits[i / 64] |= 1 << uint(i % 64)
+}
+
+// Remove removes slot index i from the set.
+func (s *SlotSet) Remove(i int) {
+	if len(s.bits) <= i / 64 {
+		return
+	}
+	s.bits[i / 64] &^= 1 << uint(i % 64)
+}
+
+// Contains returns true if slot index i is in the set.
+func (s *SlotSet) Contains(i int) bool {
+	if len(s.bits) <= i / 64 {
+		return false
+	}
+	return s.bits[i / 64] & (1 << uint(i % 64)) != 0
+}
+
+// Clear removes all slot indices from the set.
+func (s *SlotSet) Clear() {
+	s.bits = nil
+}
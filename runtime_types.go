@@ -0,0 +1,83 @@
+package main
+
+import "go/token"
+import "go/types"
+
+
+// RuntimePackagePath is the import path of the package that provides
+// runtime support for the code that defimpl generates.
+const RuntimePackagePath = "defimpl/runtime"
+
+// runtimeGenericTypes holds stand-ins for the generic types of the
+// runtime package that slot types can be instances of.  Slot types are
+// compared for identity, so there must be only one stand-in for each.
+var runtimeGenericTypes = map[string]*types.Named{}
+
+// runtimeGenericType returns the stand-in for the named generic type
+// from the runtime package.  The type has one type parameter with the
+// specified constraint, e.g. "any" or "comparable".
+func runtimeGenericType(name string, constraint string) *types.Named {
+	if named, ok := runtimeGenericTypes[name]; ok {
+		return named
+	}
+	pkg := types.NewPackage(RuntimePackagePath, "runtime")
+	tn := types.NewTypeName(token.NoPos, pkg, name, nil)
+	named := types.NewNamed(tn, types.NewStruct(nil, nil), nil)
+	tparam := types.NewTypeParam(
+		types.NewTypeName(token.NoPos, pkg, "T", nil),
+		types.Universe.Lookup(constraint).Type())
+	named.SetTypeParams([]*types.TypeParam{ tparam })
+	runtimeGenericTypes[name] = named
+	return named
+}
+
+// instantiate returns the instance of generic with type argument elt.
+func instantiate(generic *types.Named, elt types.Type) types.Type {
+	t, err := types.Instantiate(nil, generic, []types.Type{ elt }, true)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// isRuntimeType returns true if t is an instance of one of the generic
+// types of the runtime package.
+func isRuntimeType(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	for _, generic := range runtimeGenericTypes {
+		if named.Origin() == generic {
+			return true
+		}
+	}
+	return false
+}
+
+// orderedSetOf returns the type runtime.OrderedSet[elt].
+func orderedSetOf(elt types.Type) types.Type {
+	return instantiate(runtimeGenericType("OrderedSet", "comparable"), elt)
+}
+
+// queueOf returns the type runtime.Queue[elt].
+func queueOf(elt types.Type) types.Type {
+	return instantiate(runtimeGenericType("Queue", "any"), elt)
+}
+
+// listenersOf returns the type runtime.Listeners[f].
+func listenersOf(f types.Type) types.Type {
+	return instantiate(runtimeGenericType("Listeners", "any"), f)
+}
+
+// isQueue returns true if t is an instance of runtime.Queue.
+func isQueue(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && named.Origin() == runtimeGenericType("Queue", "any")
+}
+
+// isOrderedSet returns true if t is an instance of runtime.OrderedSet.
+func isOrderedSet(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && named.Origin() == runtimeGenericType("OrderedSet", "comparable")
+}
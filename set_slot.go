@@ -0,0 +1,98 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+// setSlotIsOrdered returns true if any of the verbs that concern the
+// named slot of idef has the ordered:"true" option.
+func setSlotIsOrdered(idef *InterfaceDefinition, slot string) bool {
+	return slotOption(idef, slot, "ordered") == "true"
+}
+
+
+// setVerbPhrase is embedded in the VerbPhrases of the set verbs.
+type setVerbPhrase struct {
+	slotVerbPhrase
+	element_type types.Type
+	ordered bool
+}
+
+// ElementType returns the type of the members of the set.
+func (vp *setVerbPhrase) ElementType() types.Type {
+	return vp.element_type
+}
+
+// Ordered returns true if the set remembers the order in which
+// members were added.
+func (vp *setVerbPhrase) Ordered() bool {
+	return vp.ordered
+}
+
+// isVariadic returns true if the method declared by field has a
+// variadic parameter.
+func isVariadic(field *ast.Field) bool {
+	ft, ok := field.Type.(*ast.FuncType)
+	if !ok || ft.Params == nil || len(ft.Params.List) == 0 {
+		return false
+	}
+	_, ok = ft.Params.List[len(ft.Params.List) - 1].Type.(*ast.Ellipsis)
+	return ok
+}
+
+// newSetVerbPhrase does the work that is common to the NewVerbPhrase
+// methods of the set verbs.  tmpl is the template to check the method
+// signature against.  The slot of an ordered set is a
+// runtime.OrderedSet, otherwise it is a map with empty struct values.
+func newSetVerbPhrase(ctx *context, vd VerbDefinition, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment, tmpl *template.Template) (setVerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return setVerbPhrase{}, err
+	}
+	elt, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, tmpl)
+	if err != nil {
+		return setVerbPhrase{}, err
+	}
+	if !types.Comparable(elt) {
+		return setVerbPhrase{}, fmt.Errorf("defimpl: %s: verb %q: the element type %s of a set must be comparable",
+			ctx.fset.Position(comment.Slash), vd.Tag(), elt)
+	}
+	vp := setVerbPhrase{
+		slotVerbPhrase: slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+		},
+		element_type: elt,
+		ordered: setSlotIsOrdered(idef, slot),
+	}
+	if vp.ordered {
+		vp.slot_type = orderedSetOf(elt)
+	} else {
+		vp.slot_type = types.NewMap(elt, types.NewStruct(nil, nil))
+	}
+	return vp, nil
+}
+
+// addMember returns a statement that adds the member v to the set,
+// which must not be nil.
+func (vp *setVerbPhrase) addMember(v string) string {
+	if vp.Ordered() {
+		return fmt.Sprintf("x.%s.Add(%s)", vp.SlotName(), v)
+	}
+	return fmt.Sprintf("x.%s[%s] = struct{}{}", vp.SlotName(), v)
+}
+
+// removeMember returns a statement that removes the member v from the
+// set.
+func (vp *setVerbPhrase) removeMember(v string) string {
+	if vp.Ordered() {
+		return fmt.Sprintf("x.%s.Remove(%s)", vp.SlotName(), v)
+	}
+	return fmt.Sprintf("delete(x.%s, %s)", vp.SlotName(), v)
+}
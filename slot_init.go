@@ -0,0 +1,82 @@
+package main
+
+import "fmt"
+import "go/types"
+
+
+// slotInitialValue returns the expression for the initial value of the
+// named slot of idef, or "" if the slot starts out with the zero value
+// of its type.  The initial value is given by either the default
+// option, a Go expression, e.g.
+//
+//	Name() string  // defimpl:"read name" default:"\"unnamed\""
+//
+// or the init option, which names a function of no arguments, e.g.
+//
+//	Cache() *Cache  // defimpl:"read cache" init:"newCache"
+func slotInitialValue(idef *InterfaceDefinition, slot string) string {
+	if expr := slotOption(idef, slot, "default"); expr != "" {
+		return expr
+	}
+	if f := slotOption(idef, slot, "init"); f != "" {
+		return f + "()"
+	}
+	return ""
+}
+
+// initializedFlag returns the name of the struct field that records
+// whether the named slot has been given its initial value.
+func initializedFlag(slot string) string {
+	return "defimpl_initialized_" + slot
+}
+
+// InitializedFlag returns the name of the struct field that records
+// whether the slot has been given its initial value, or "" if the slot
+// has neither the default nor the init option.
+func (spec *slotSpec) InitializedFlag() string {
+	if slotInitialValue(spec.InterfaceDefinition(), spec.SlotName()) == "" {
+		return ""
+	}
+	return initializedFlag(spec.SlotName())
+}
+
+// Initialize returns the code that gives the slot its initial value
+// the first time any method that concerns it is called, or "" if the
+// slot has neither the default nor the init option.
+func (svp *slotVerbPhrase) Initialize() string {
+	return svp.SlotSpec().Initialize()
+}
+
+func (spec *slotSpec) Initialize() string {
+	return spec.InitializeObject("x")
+}
+
+// InitializeObject is like Initialize but for the slot of the object
+// o, e.g. that which the equal verb compares with x.
+func (spec *slotSpec) InitializeObject(o string) string {
+	value := slotInitialValue(spec.InterfaceDefinition(), spec.SlotName())
+	if value == "" {
+		return ""
+	}
+	slot := o + "." + spec.SlotName()
+	flag := o + "." + initializedFlag(spec.SlotName())
+	assign := fmt.Sprintf("%s = %s", slot, value)
+	if spec.Atomic() != "" {
+		assign = fmt.Sprintf("%s.Store(%s)", slot, value)
+	}
+	return fmt.Sprintf("if !%s {\n\t%s = true\n\t%s\n}", flag, flag, assign)
+}
+
+// Allocate returns the code that allocates an empty slice or map for a
+// slice or map valued slot that is still nil, so that the read verb
+// never returns nil.
+func (svp *slotVerbPhrase) Allocate() string {
+	t := svp.SlotSpec().SlotType()
+	switch t.(type) {
+	case *types.Slice, *types.Map:
+		slot := "x." + svp.SlotName()
+		return fmt.Sprintf("if %s == nil {\n\t%s = %s{}\n}",
+			slot, slot, svp.TypeString(t))
+	}
+	return ""
+}
@@ -21,6 +21,10 @@ type SlotVerbPhrase interface {
 	SlotSpec() *slotSpec
 	SetSlotSpec(*slotSpec)
 	TypeString(t types.Type) string
+	// BeforeMutation and AfterMutation return the code that
+	// MutationHooks contribute to methods that modify the slot.
+	BeforeMutation() string
+	AfterMutation() string
 }
 
 type slotVerbPhrase struct {
@@ -57,6 +61,16 @@ func (svp *slotVerbPhrase) SetSlotSpec(spec *slotSpec) {
 	svp.slot_spec = spec
 }
 
+// Load is the slotSpec's Load, for templates.
+func (svp *slotVerbPhrase) Load(x string) string {
+	return svp.SlotSpec().Load(x)
+}
+
+// Store is the slotSpec's Store, for templates.
+func (svp *slotVerbPhrase) Store(x string, value string) string {
+	return svp.SlotSpec().Store(x, value)
+}
+
 func (svp *slotVerbPhrase) SlotType() types.Type {
 	return svp.slot_type
 }
@@ -65,6 +79,13 @@ func (svp *slotVerbPhrase) TypeString(t types.Type) string {
 	return types.TypeString(t, svp.InterfaceDefinition().File.Qualifier)
 }
 
+// Copy returns true if the slot has the copy:"true" option, in which
+// case the read and set verbs copy the slice or map that they return
+// or are given so that callers can't share storage with the slot.
+func (svp *slotVerbPhrase) Copy() bool {
+	return slotOption(svp.InterfaceDefinition(), svp.SlotName(), "copy") == "true"
+}
+
 
 type slotSpec struct {
 	VerbPhrases []SlotVerbPhrase
@@ -82,10 +103,138 @@ func (spec *slotSpec) SlotName() string {
 	return spec.VerbPhrases[0].(SlotVerbPhrase).SlotName()
 }
 
+// SlotType returns the type of the slot.  Some verbs, e.g. length,
+// don't determine the slot type, so the first VerbPhrase that does is
+// consulted.
 func (spec *slotSpec) SlotType() types.Type {
-	return spec.VerbPhrases[0].(SlotVerbPhrase).SlotType()
+	if t := spec.declaredType(); t != nil {
+		return t
+	}
+	// A counter whose verbs don't mention its type, e.g. IncHits(),
+	// counts with an int.
+	if slotHasVerb(spec.InterfaceDefinition(), spec.SlotName(), counterVerbs...) {
+		return types.Typ[types.Int]
+	}
+	return nil
+}
+
+// declaredType returns the slot type established by the method
+// signatures of the slot's verbs, or nil if none does so.
+func (spec *slotSpec) declaredType() types.Type {
+	for _, svp := range spec.VerbPhrases {
+		if t := svp.SlotType(); t != nil {
+			return t
+		}
+	}
+	return nil
+}
+
+// TypeString returns the string representation of t as it should
+// appear in the output file.
+func (spec *slotSpec) TypeString(t types.Type) string {
+	return spec.VerbPhrases[0].TypeString(t)
 }
 
+// FieldType returns the type of the slot's field in the struct as it
+// should appear in the output file.  It differs from the slot type for
+// atomic slots.
+func (spec *slotSpec) FieldType() string {
+	t := spec.SlotType()
+	atomic := spec.Atomic()
+	if atomic == "Pointer" {
+		return fmt.Sprintf("atomic.Pointer[%s]",
+			spec.TypeString(t.(*types.Pointer).Elem()))
+	}
+	if atomic != "" {
+		return "atomic." + atomic
+	}
+	return spec.TypeString(t)
+}
+
+// Atomic returns the name of the sync/atomic type of the slot, or "".
+func (spec *slotSpec) Atomic() string {
+	return atomicTypeName(spec.InterfaceDefinition(), spec.SlotName(), spec.SlotType())
+}
+
+// BeforeMutation returns the code that MutationHooks contribute ahead
+// of replacing the value of the slot.
+func (spec *slotSpec) BeforeMutation() string {
+	return beforeMutation(wholeSlot{ spec.VerbPhrases[0] })
+}
+
+// AfterMutation returns the code that MutationHooks contribute
+// following the replacement of the value of the slot.
+func (spec *slotSpec) AfterMutation() string {
+	return afterMutation(wholeSlot{ spec.VerbPhrases[0] })
+}
+
+// CopyExpression returns an expression that evaluates to a copy of
+// expr, which should be a value of the slot's type.  Collection valued
+// slots are copied so that the copy doesn't share storage with expr.
+func (spec *slotSpec) CopyExpression(expr string) string {
+	switch t := spec.SlotType().(type) {
+	case *types.Slice:
+		return fmt.Sprintf("append(%s(nil), %s...)", spec.TypeString(t), expr)
+	case *types.Map:
+		return fmt.Sprintf("maps.Clone(%s)", expr)
+	}
+	// Each of the generic types of the runtime package has a Clone
+	// method.
+	if isRuntimeType(spec.SlotType()) {
+		return expr + ".Clone()"
+	}
+	return expr
+}
+
+// Load returns an expression for a copy, as made by CopyExpression, of
+// the value of the slot of x.  An atomic slot is loaded atomically.
+func (spec *slotSpec) Load(x string) string {
+	slot := x + "." + spec.SlotName()
+	if spec.Atomic() != "" {
+		return slot + ".Load()"
+	}
+	return spec.CopyExpression(slot)
+}
+
+// Store returns a statement that sets the slot of x to a copy, as
+// made by CopyExpression, of value.  An atomic slot is stored
+// atomically.
+func (spec *slotSpec) Store(x string, value string) string {
+	slot := x + "." + spec.SlotName()
+	if spec.Atomic() != "" {
+		return fmt.Sprintf("%s.Store(%s)", slot, value)
+	}
+	return fmt.Sprintf("%s = %s", slot, spec.CopyExpression(value))
+}
+
+// SaveState returns statements that declare variables holding
+// everything about the slot of x that verbs can change: its value, as
+// made by Load, in the variable v, and, if the slot has them, whether
+// it has been initialized and whether it has been set in v_initialized
+// and v_present.
+func (spec *slotSpec) SaveState(x, v string) string {
+	code := []string{ fmt.Sprintf("%s := %s", v, spec.Load(x)) }
+	if flag := spec.InitializedFlag(); flag != "" {
+		code = append(code, fmt.Sprintf("%s_initialized := %s.%s", v, x, flag))
+	}
+	if spec.PresenceFlag() != "" {
+		code = append(code, fmt.Sprintf("%s_present := %s", v, spec.Presence(x)))
+	}
+	return strings.Join(code, "\n")
+}
+
+// RestoreState returns statements that reinstate the state of the
+// slot of x that SaveState saved in variables named after v.
+func (spec *slotSpec) RestoreState(x, v string) string {
+	code := []string{ spec.Store(x, v) }
+	if flag := spec.InitializedFlag(); flag != "" {
+		code = append(code, fmt.Sprintf("%s.%s = %s_initialized", x, flag, v))
+	}
+	if spec.PresenceFlag() != "" {
+		code = append(code, spec.SetPresence(x, v + "_present"))
+	}
+	return strings.Join(code, "\n")
+}
 
 // addSlotSpec searches the InterfaceDefinition for a slotSpec with
 // the same slot name as that of svp, and, failiing to find one,
@@ -101,9 +250,13 @@ func addSlotSpec(idef *InterfaceDefinition, svp SlotVerbPhrase) error {
 			if svp.SlotName() == svp1.SlotName() {
 				// Verbs like length might not be able
 				// to determine, nor need a SlotType.
-				if svp.SlotType() != nil && !teq(svp.SlotType(), svp1.SlotType()) {
+				if svp.SlotType() != nil {
+					rangeOverMap(svp1.SlotSpec(), svp.SlotType())
+				}
+				t := svp1.SlotSpec().declaredType()
+				if svp.SlotType() != nil && t != nil && !teq(svp.SlotType(), t) {
 					return fmt.Errorf("Types %s and %s don't match",
-						svp.SlotType(), svp1.SlotType())
+						svp.SlotType(), t)
 				}
 				svp.SetSlotSpec(svp1.SlotSpec())
 				svp.SlotSpec().VerbPhrases = append(svp.SlotSpec().VerbPhrases, svp)
@@ -128,13 +281,78 @@ func (vd *slotVerbDefinition) StructBody(vp VerbPhrase) (string, error) {
 	}
 	if !svp.SlotSpec().emitted {
 		svp.SlotSpec().emitted = true
-		return fmt.Sprintf("\t%s %s\n", svp.SlotName(),
-			svp.TypeString(svp.SlotType())), nil
+		body := fmt.Sprintf("\t%s %s\n", svp.SlotName(),
+			svp.SlotSpec().FieldType())
+		if slotInitialValue(svp.InterfaceDefinition(), svp.SlotName()) != "" {
+			body += fmt.Sprintf("\t%s bool\n", initializedFlag(svp.SlotName()))
+		}
+		if slotHasVerb(svp.InterfaceDefinition(), svp.SlotName(), "computed") {
+			body += fmt.Sprintf("\t%s bool\n", computedFlag(svp.SlotName()))
+		}
+		body += presenceStructBody(svp.InterfaceDefinition(), svp.SlotName())
+		body += chanStructBody(svp.InterfaceDefinition(), svp.SlotName())
+		return body, nil
 	}
 	return "", nil
 }
 
 
+// slotTags returns the defimpl comments, parsed as struct tags, of
+// whichever methods of idef concern the named slot.
+func slotTags(idef *InterfaceDefinition, slot string) []reflect.StructTag {
+	tags := []reflect.StructTag{}
+	for _, field := range idef.Fields() {
+		if field.Comment == nil {
+			continue
+		}
+		for _, c := range field.Comment.List {
+			tag := reflect.StructTag(c.Text[2:])
+			val, ok := tag.Lookup("defimpl")
+			if !ok {
+				continue
+			}
+			split := strings.Split(val, " ")
+			if len(split) != 2 || split[1] != slot {
+				continue
+			}
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// slotOption returns the value of the option named by key from the
+// defimpl comments of whichever methods of idef concern the named slot.
+// Options are additional keys in a defimpl comment, e.g.
+//
+//	AddEvent(Event)  // defimpl:"append events" order:"Before"
+//
+// An option need only appear in one of those comments to apply to the
+// slot.  slotOption returns "" if no such option is present.
+func slotOption(idef *InterfaceDefinition, slot string, key string) string {
+	for _, tag := range slotTags(idef, slot) {
+		if opt, ok := tag.Lookup(key); ok {
+			return opt
+		}
+	}
+	return ""
+}
+
+// slotHasVerb returns true if any of the methods of idef apply one of
+// the specified verbs to the named slot.
+func slotHasVerb(idef *InterfaceDefinition, slot string, verbs ...string) bool {
+	for _, tag := range slotTags(idef, slot) {
+		verb := strings.Split(tag.Get("defimpl"), " ")[0]
+		for _, v := range verbs {
+			if verb == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+
 // parse_slot_verb_phrase parses the defimpl comment for verbs that
 // parse to aa SlotVerbPhrase.
 func parse_slot_verb_phrase(ctx *context, field *ast.Field, comment *ast.Comment) (string, error) {
@@ -0,0 +1,105 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+
+
+// slotOrdering describes how the elements of a sorted slice valued
+// slot are ordered.  It is specified by the order option, e.g.
+//
+//	AddEvent(...Event)  // defimpl:"append events" order:"Before"
+//
+// The option names either a method of the element type or a function
+// of two elements, e.g. cmp.Compare.  Either can return a bool, true if
+// the first element sorts before the second, or, like cmp.Compare, an
+// int.
+type slotOrdering struct {
+	name string
+	// method is true if name is a method of the element type.
+	method bool
+	// less is true if the comparison returns a bool.
+	less bool
+}
+
+// Less returns an expression that is true if the element expression
+// a sorts before the element expression b.
+func (o *slotOrdering) Less(a, b string) string {
+	call := ""
+	if o.method {
+		call = fmt.Sprintf("%s.%s(%s)", a, o.name, b)
+	} else {
+		call = fmt.Sprintf("%s(%s, %s)", o.name, a, b)
+	}
+	if o.less {
+		return call
+	}
+	return call + " < 0"
+}
+
+// returnsBool returns true if sig has a single bool result.
+func returnsBool(sig *types.Signature) bool {
+	if sig.Results().Len() != 1 {
+		return false
+	}
+	b, ok := sig.Results().At(0).Type().Underlying().(*types.Basic)
+	return ok && b.Kind() == types.Bool
+}
+
+// getSlotOrdering returns the slotOrdering for the named slot of idef,
+// whose elements are of type elt, or nil if the slot isn't sorted.
+func getSlotOrdering(ctx *context, idef *InterfaceDefinition, slot string, elt types.Type) (*slotOrdering, error) {
+	name := slotOption(idef, slot, "order")
+	if name == "" {
+		return nil, nil
+	}
+	var pkg *types.Package
+	if named, ok := elt.(*types.Named); ok {
+		pkg = named.Obj().Pkg()
+	}
+	obj, _, _ := types.LookupFieldOrMethod(elt, true, pkg, name)
+	if f, ok := obj.(*types.Func); ok {
+		return &slotOrdering{
+			name: name,
+			method: true,
+			less: returnsBool(f.Type().(*types.Signature)),
+		}, nil
+	}
+	if obj != nil {
+		return nil, fmt.Errorf("defimpl: order %q for slot %q: %s is not a method",
+			name, slot, obj)
+	}
+	// Look for a function of that name in the package being
+	// processed.  Otherwise assume that it is like cmp.Compare.
+	o := &slotOrdering{ name: name }
+	for _, file := range ctx.astFiles {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv != nil || fd.Name.Name != name {
+				continue
+			}
+			if f, ok := ctx.info.Defs[fd.Name].(*types.Func); ok {
+				o.less = returnsBool(f.Type().(*types.Signature))
+			}
+		}
+	}
+	return o, nil
+}
+
+
+// sortedVerbPhrase is embedded in the VerbPhrases of the verbs that
+// honor the order option.
+type sortedVerbPhrase struct {
+	slotVerbPhrase
+	ordering *slotOrdering
+}
+
+// Sorted returns true if the slot's elements are kept in order.
+func (vp *sortedVerbPhrase) Sorted() bool {
+	return vp.ordering != nil
+}
+
+// Less is described by (*slotOrdering).Less.
+func (vp *sortedVerbPhrase) Less(a, b string) string {
+	return vp.ordering.Less(a, b)
+}
@@ -0,0 +1,174 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "reflect"
+import "slices"
+import "strings"
+import "text/template"
+
+
+// queueVerbs are the verbs that require a slot to be a
+// runtime.Queue rather than a slice.
+var queueVerbs = []string{ "enqueue", "dequeue" }
+
+// queueSlotVerbs are the verbs other than queueVerbs that can be
+// applied to a runtime.Queue valued slot.
+var queueSlotVerbs = append([]string{ "push", "pop", "peek", "length" }, presenceVerbs...)
+
+// checkQueueSlot returns an error positioned at the first defimpl
+// comment of idef that applies to the named queue valued slot a verb
+// that only works with slices, e.g. index, or nil.  So that the error
+// is only reported once, it is only returned when comment is that of
+// the first of the queueVerbs of the slot.
+func checkQueueSlot(ctx *context, idef *InterfaceDefinition, slot string, comment *ast.Comment) error {
+	var first *ast.Comment
+	var bad *ast.Comment
+	bad_verb := ""
+	for _, field := range idef.Fields() {
+		if field.Comment == nil {
+			continue
+		}
+		for _, c := range field.Comment.List {
+			val, ok := reflect.StructTag(c.Text[2:]).Lookup("defimpl")
+			if !ok {
+				continue
+			}
+			split := strings.Split(val, " ")
+			if len(split) != 2 || split[1] != slot {
+				continue
+			}
+			switch {
+			case slices.Contains(queueVerbs, split[0]):
+				if first == nil {
+					first = c
+				}
+			case slices.Contains(queueSlotVerbs, split[0]):
+			case bad == nil:
+				bad = c
+				bad_verb = split[0]
+			}
+		}
+	}
+	if bad == nil || first != comment {
+		return nil
+	}
+	return fmt.Errorf("defimpl: %s: verb %q can't be used with slot %q, which is a queue because of its enqueue or dequeue verb",
+		ctx.fset.Position(bad.Slash), bad_verb, slot)
+}
+
+// sequenceVerbPhrase is embedded in the VerbPhrases of the push, pop,
+// peek, enqueue and dequeue verbs.
+type sequenceVerbPhrase struct {
+	slotVerbPhrase
+	element_type types.Type
+	// queue is true if the slot is a runtime.Queue rather than a
+	// slice.
+	queue bool
+	// form identifies which of the verb's templates matched the
+	// method signature.
+	form string
+}
+
+// ElementType returns the type of the elements of the slot.
+func (vp *sequenceVerbPhrase) ElementType() types.Type {
+	return vp.element_type
+}
+
+// Queue returns true if the slot is a runtime.Queue rather than a
+// slice.
+func (vp *sequenceVerbPhrase) Queue() bool {
+	return vp.queue
+}
+
+func (vp *sequenceVerbPhrase) Form() string {
+	return vp.form
+}
+
+// newSequenceVerbPhrase does the work that is common to the
+// NewVerbPhrase methods of the push, pop, peek, enqueue and dequeue
+// verbs.  The method signature is checked against each of the named
+// templates from tmpl in turn.  The slot is a runtime.Queue if any of
+// the verbs that concern it is enqueue or dequeue, otherwise a slice.
+func newSequenceVerbPhrase(ctx *context, vd VerbDefinition, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment, tmpl *template.Template, forms ...string) (sequenceVerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return sequenceVerbPhrase{}, err
+	}
+	if vd.Mutating() {
+		if err := rejectChildren(ctx, vd, idef, slot, comment); err != nil {
+			return sequenceVerbPhrase{}, err
+		}
+	}
+	// Adding elements at the back would defeat the order option.
+	if (vd.Tag() == "push" || vd.Tag() == "enqueue") && slotOption(idef, slot, "order") != "" {
+		return sequenceVerbPhrase{}, fmt.Errorf("defimpl: %s: verb %q can't be used with sorted slot %q",
+			ctx.fset.Position(comment.Slash), vd.Tag(), slot)
+	}
+	vp := sequenceVerbPhrase{
+		slotVerbPhrase: slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+		},
+		queue: slotHasVerb(idef, slot, queueVerbs...),
+	}
+	if vp.queue {
+		if err := checkQueueSlot(ctx, idef, slot, comment); err != nil {
+			return sequenceVerbPhrase{}, err
+		}
+	}
+	for _, f := range forms {
+		elt, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, tmpl.Lookup(f))
+		if err == nil {
+			vp.element_type = elt
+			vp.form = f
+			break
+		}
+	}
+	if vp.form == "" {
+		return sequenceVerbPhrase{}, fmt.Errorf("defimpl: %s: Method signature inappropriate for verb %q",
+			ctx.fset.Position(comment.Slash), vd.Tag())
+	}
+	if vp.queue {
+		vp.slot_type = queueOf(vp.element_type)
+	} else {
+		vp.slot_type = types.NewSlice(vp.element_type)
+	}
+	return vp, nil
+}
+
+// journalPushBackCapture, journalPushBackUndo and journalPushBackRedo
+// implement the Invertible interface for the push and enqueue verbs,
+// which add elements to the back of the slot.
+func (vp *sequenceVerbPhrase) journalPushBackCapture() string {
+	added := "slices.Clone(elements)"
+	if !strings.HasSuffix(vp.Form(), "_variadic") {
+		added = fmt.Sprintf("[]%s{ v }", vp.TypeString(vp.ElementType()))
+	}
+	length := fmt.Sprintf("len(x.%s)", vp.SlotName())
+	if vp.Queue() {
+		length = fmt.Sprintf("x.%s.Len()", vp.SlotName())
+	}
+	return fmt.Sprintf("defimpl_added := %s\ndefimpl_n := %s", added, length)
+}
+
+func (vp *sequenceVerbPhrase) journalPushBackUndo() string {
+	slot := "x." + vp.SlotName()
+	if vp.Queue() {
+		return fmt.Sprintf("for %s.Len() > defimpl_n {\n%s.PopBack()\n}", slot, slot)
+	}
+	return fmt.Sprintf("%s = slices.Delete(%s, defimpl_n, len(%s))", slot, slot, slot)
+}
+
+func (vp *sequenceVerbPhrase) journalPushBackRedo() string {
+	slot := "x." + vp.SlotName()
+	if vp.Queue() {
+		return fmt.Sprintf("for _, v := range defimpl_added {\n%s.PushBack(v)\n}", slot)
+	}
+	return fmt.Sprintf("%s = append(%s, defimpl_added...)", slot, slot)
+}
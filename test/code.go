@@ -3,9 +3,13 @@
 // The program verifies that the generated code functions properly.
 package test
 
+import "fmt"
 import "reflect"
 import tmpl "text/template"
 import "go/ast"
+import "iter"
+import "sync/atomic"
+import "defimpl/runtime"
 
 //go:generate defimpl
 
@@ -19,9 +23,14 @@ type Thing interface {
 	// related
 	AddRelated(...Thing)        // defimpl:"append related"
 	RemoveRelated(Thing)        // defimpl:"delete related"
+	InsertRelated(int, Thing)   // defimpl:"insert related"
+	RemoveRelatedAt(int) Thing  // defimpl:"removeat related"
+	ReplaceRelated(int, Thing) Thing // defimpl:"replace related"
 	GetRelated(int) Thing       // defimpl:"index related"
 	CountRelated() int          // defimpl:"length related"
 	DoRelated(func(Thing) bool) // defimpl:"iterate related"
+	AllRelated() iter.Seq[Thing]             // defimpl:"range related"
+	EnumerateRelated() iter.Seq2[int, Thing] // defimpl:"range related"
 
 	// These are added to test that the proper packages are
 	// imported in the output file.
@@ -38,6 +47,476 @@ type SpecialThing interface {
 	Specialty() interface{}   // defimpl:"read specialty"
 }
 
+// Record is used to test the (DIRTY) and (VIEW) options.
+// (DIRTY) (VIEW)
+type Record interface {
+	Title() string        // defimpl:"read title"
+	SetTitle(string)      // defimpl:"set title"
+	AddTags(...string)    // defimpl:"append tags"
+	RemoveTag(string)     // defimpl:"delete tags"
+	Tags() []string       // defimpl:"read tags"
+	TagCount() int        // defimpl:"length tags"
+	DoTags(func(string) bool)  // defimpl:"iterate tags"
+	FindTag(func(string) bool) (string, bool)  // defimpl:"find tags"
+	FilterTags(func(string) bool) []string     // defimpl:"filter tags"
+	CountTags(func(string) bool) int           // defimpl:"count tags"
+	AnyTag(func(string) bool) bool             // defimpl:"any tags"
+	AllTags(func(string) bool) bool            // defimpl:"all tags"
+	SetAttributes(map[string]int)            // defimpl:"set attributes"
+	AttributeMap() map[string]int            // defimpl:"read attributes"
+	Attributes() iter.Seq2[string, int]      // defimpl:"range attributes"
+	DirtySlots() []string
+	ClearDirty()
+}
+
+// Document is used to test the (JOURNAL) and (SNAPSHOT) options.
+// (JOURNAL) (SNAPSHOT)
+type Document interface {
+	Text() string           // defimpl:"read text"
+	SetText(string)         // defimpl:"set text"
+	AddLines(...string)     // defimpl:"append lines"
+	RemoveLine(string)      // defimpl:"delete lines"
+	Line(int) string        // defimpl:"index lines"
+	LineCount() int         // defimpl:"length lines"
+	Snapshot() any
+	Restore(any)
+}
+
+// Labeled is used to test the set verbs.  It has the (SNAPSHOT)
+// option so that set valued slots get copied, and the (DIRTY) option
+// to check that adding or removing nothing doesn't dirty a slot.
+// (DIRTY) (SNAPSHOT)
+type Labeled interface {
+	AddLabel(string)          // defimpl:"add labels"
+	HasLabel(string) bool     // defimpl:"contains labels"
+	RemoveLabel(string)       // defimpl:"remove labels"
+	Labels() []string         // defimpl:"members labels"
+	LabelCount() int          // defimpl:"length labels"
+	AddKeywords(...string)    // defimpl:"add keywords" ordered:"true"
+	HasKeyword(string) bool   // defimpl:"contains keywords"
+	RemoveKeyword(string)     // defimpl:"remove keywords"
+	Keywords() []string       // defimpl:"members keywords"
+	KeywordCount() int        // defimpl:"length keywords"
+	Snapshot() any
+	Restore(any)
+	DirtySlots() []string
+	ClearDirty()
+}
+
+// Event is used to test sorted slots.
+type Event struct {
+	Time int
+	Name string
+}
+
+func (e Event) Before(other Event) bool {
+	return e.Time < other.Time
+}
+
+// Schedule is used to test the order option and the find verb.
+type Schedule interface {
+	AddEvents(...Event)             // defimpl:"append events" order:"Before"
+	Event(int) Event                // defimpl:"index events"
+	EventCount() int                // defimpl:"length events"
+	FindEvent(Event) (Event, bool)  // defimpl:"find events"
+	EventIndex(Event) (int, bool)   // defimpl:"find events"
+	AddPriorities(...int)           // defimpl:"append priorities" order:"cmp.Compare"
+	Priority(int) int               // defimpl:"index priorities"
+}
+
+// Roster is used to test the copy option.
+type Roster interface {
+	Names() []string               // defimpl:"read names" copy:"true"
+	SetNames([]string)             // defimpl:"set names"
+	Scores() map[string]int        // defimpl:"read scores" copy:"true"
+	SetScores(map[string]int)      // defimpl:"set scores"
+}
+
+// Stats is used to test the counter verbs.
+type Stats interface {
+	IncHits()                   // defimpl:"increment hits"
+	DecHits() int               // defimpl:"decrement hits"
+	Hits() int                  // defimpl:"read hits"
+	AddBytes(int64) int64       // defimpl:"add bytes"
+	Bytes() int64               // defimpl:"read bytes"
+	AddWeight(float64)          // defimpl:"add weight"
+	Weight() float64            // defimpl:"read weight"
+}
+
+// SafeStats is used to test the counter verbs with the (THREADSAFE)
+// option.
+// (THREADSAFE)
+type SafeStats interface {
+	IncHits() int64             // defimpl:"increment hits"
+	DecHits()                   // defimpl:"decrement hits"
+	Hits() int64                // defimpl:"read hits"
+	SetHits(int64)              // defimpl:"set hits"
+	IncUnsigned()               // defimpl:"increment unsigned"
+	DecUnsigned() uint32        // defimpl:"decrement unsigned"
+	AddWeight(float64) float64  // defimpl:"add weight"
+	Weight() float64            // defimpl:"read weight"
+}
+
+// StatusFlags is the type of the bitmask slot of Status.
+type StatusFlags uint8
+
+const (
+	FlagVisible StatusFlags = 1 << iota
+	FlagLocked
+)
+
+// Status is used to test the flag and bit verbs.
+type Status interface {
+	Enable()                    // defimpl:"enable enabled"
+	Disable()                   // defimpl:"disable enabled"
+	ToggleEnabled() bool        // defimpl:"toggle enabled"
+	IsEnabled() bool            // defimpl:"is enabled"
+	SetVisible()                // defimpl:"setbit flags" bit:"FlagVisible"
+	ClearVisible()              // defimpl:"clearbit flags" bit:"FlagVisible"
+	IsVisible() bool            // defimpl:"hasbit flags" bit:"FlagVisible"
+	Lock()                      // defimpl:"setbit flags" bit:"FlagLocked"
+	IsLocked() bool             // defimpl:"hasbit flags" bit:"FlagLocked"
+	Flags() StatusFlags         // defimpl:"read flags"
+}
+
+// Settings is used to test the default and init options and the lazy
+// allocation of slice and map valued slots.
+type Settings interface {
+	Name() string               // defimpl:"read name" default:"\"unnamed\""
+	SetName(string)             // defimpl:"set name"
+	IncRetries() int            // defimpl:"increment retries" default:"3"
+	Limits() map[string]int     // defimpl:"read limits"
+	Paths() []string            // defimpl:"read paths"
+	CacheSize() int             // defimpl:"length cache" init:"newSettingsCache"
+	Cache() map[string]string   // defimpl:"read cache"
+}
+
+func newSettingsCache() map[string]string {
+	return map[string]string{ "a": "b" }
+}
+
+// Profile is used to test the presence verbs, and that the (JOURNAL)
+// and (SNAPSHOT) options and the string and marshal verbs respect them.
+// (JOURNAL) (SNAPSHOT)
+type Profile interface {
+	Age() int                   // defimpl:"read age"
+	SetAge(int)                 // defimpl:"set age"
+	HasAge() bool               // defimpl:"has age"
+	UnsetAge()                  // defimpl:"unset age"
+	Nickname() string           // defimpl:"read nickname" default:"\"none\""
+	SetNickname(string)         // defimpl:"set nickname"
+	NicknameIsSet() bool        // defimpl:"isset nickname"
+	ClearNickname()             // defimpl:"unset nickname"
+	Email() string              // defimpl:"read email"
+	SetEmail(string)            // defimpl:"set email"
+	Tags() []string             // defimpl:"read tags"
+	AddTags(...string)          // defimpl:"append tags"
+	HasTags() bool              // defimpl:"has tags"
+	Logins() int                // defimpl:"read logins"
+	IncLogins() int             // defimpl:"increment logins"
+	LoginsIsSet() bool          // defimpl:"isset logins"
+	String() string             // defimpl:"string"
+	MarshalJSON() ([]byte, error)  // defimpl:"marshal"
+	SetJournaler(runtime.Journaler)
+	Snapshot() any
+	Restore(any)
+}
+
+// Entity is used to test the id verb.
+type Entity interface {
+	ID() uint64                 // defimpl:"id"
+	Name() string               // defimpl:"read name"
+}
+
+// TreeNode is used to test the children option and the root,
+// ancestors and walk verbs.
+type TreeNode interface {
+	Label() string                   // defimpl:"read label"
+	SetLabel(string)                 // defimpl:"set label"
+	Parent() TreeNode                // defimpl:"read parent"
+	SetParent(TreeNode)              // defimpl:"set parent"
+	AddChild(...TreeNode)            // defimpl:"append children" children:"SetParent"
+	InsertChild(int, TreeNode)       // defimpl:"insert children"
+	RemoveChild(TreeNode)            // defimpl:"delete children"
+	RemoveChildAt(int) TreeNode      // defimpl:"removeat children"
+	ReplaceChild(int, TreeNode) TreeNode  // defimpl:"replace children"
+	Children() []TreeNode            // defimpl:"read children"
+	Root() TreeNode                  // defimpl:"root children"
+	Ancestors() []TreeNode           // defimpl:"ancestors children"
+	Walk(func(TreeNode) bool)        // defimpl:"walk children"
+}
+
+// Button is used to test the listen and fire verbs.  It has the
+// (THREADSAFE) option so that handlers can be added concurrently.
+// (THREADSAFE)
+type Button interface {
+	OnClick(func(int)) (cancel func())  // defimpl:"listen clicked"
+	Click(int)                          // defimpl:"fire clicked"
+	OnLog(func(string, ...any)) func()  // defimpl:"listen logged"
+	Log(string, ...any)                 // defimpl:"fire logged"
+}
+
+// Mailbox is used to test the send, receive and close verbs.  The
+// notices channel is made by the function named by its init option.
+type Mailbox interface {
+	Send(string)                // defimpl:"send inbox" buffer:"2"
+	Receive() (string, bool)    // defimpl:"receive inbox"
+	Inbox() <-chan string       // defimpl:"receive inbox"
+	Close()                     // defimpl:"close inbox"
+	Notify(int)                 // defimpl:"send notices" init:"newNotices"
+	Notices() <-chan int        // defimpl:"receive notices"
+}
+
+// noticesMade counts the calls to newNotices.
+var noticesMade atomic.Int32
+
+func newNotices() chan int {
+	noticesMade.Add(1)
+	return make(chan int, 8)
+}
+
+// Rectangle is used to test the computed verb, including that undoing
+// a modification invalidates the slots computed from it.
+// (JOURNAL)
+type Rectangle interface {
+	Width() float64             // defimpl:"read width"
+	SetWidth(float64)           // defimpl:"set width"
+	Height() float64            // defimpl:"read height"
+	SetHeight(float64)          // defimpl:"set height"
+	Area() float64              // defimpl:"computed area" compute:"computeArea" depends:"width,height"
+	Label() string              // defimpl:"computed label" compute:"computeLabel" depends:"area"
+	SetJournaler(runtime.Journaler)
+}
+
+// computations counts the calls to computeArea.
+var computations int
+
+func computeArea(r Rectangle) float64 {
+	computations += 1
+	return r.Width() * r.Height()
+}
+
+func computeLabel(r Rectangle) string {
+	return fmt.Sprintf("area %g", r.Area())
+}
+
+// Builder is used to test fluent set and append verbs.
+type Builder interface {
+	WithName(string) Builder    // defimpl:"set name"
+	WithTags(...string) Builder // defimpl:"append tags"
+	Name() string               // defimpl:"read name"
+	Tags() []string             // defimpl:"read tags"
+}
+
+// MachineState is the state of a Machine.
+type MachineState int
+
+const (
+	Idle MachineState = iota
+	Running
+	Stopped
+)
+
+// Machine is used to test the swap and cas verbs with the (THREADSAFE),
+// (DIRTY) and (JOURNAL) options.  The count and current slots are
+// atomic, state isn't.
+// (THREADSAFE) (DIRTY) (JOURNAL)
+type Machine interface {
+	State() MachineState                          // defimpl:"read state"
+	SetState(MachineState) MachineState           // defimpl:"swap state"
+	CompareAndSetState(old, new MachineState) bool // defimpl:"cas state"
+	Count() int64                                 // defimpl:"read count"
+	SwapCount(int64) int64                        // defimpl:"swap count"
+	CasCount(old, new int64) bool                 // defimpl:"cas count"
+	Current() *string                             // defimpl:"read current"
+	SwapCurrent(*string) *string                  // defimpl:"swap current"
+	CasCurrent(old, new *string) bool             // defimpl:"cas current"
+	DirtySlots() []string
+	ClearDirty()
+	SetJournaler(runtime.Journaler)
+}
+
+// Point is used to test the equal and hash verbs.  Next is compared
+// by value.
+type Point interface {
+	X() int                     // defimpl:"read x"
+	SetX(int)                   // defimpl:"set x"
+	Tags() []string             // defimpl:"read tags"
+	SetTags([]string)           // defimpl:"set tags"
+	Attrs() map[string][]int    // defimpl:"read attrs"
+	SetAttrs(map[string][]int)  // defimpl:"set attrs"
+	Next() Point                // defimpl:"read next"
+	SetNext(Point)              // defimpl:"set next"
+	Equal(Point) bool           // defimpl:"equal" by:"value"
+	Hash() uint64               // defimpl:"hash"
+}
+
+// Named is used to test the slots option of the equal and hash verbs,
+// and that they see the default values of slots and can be used
+// concurrently with the (THREADSAFE) option.
+// (THREADSAFE)
+type Named interface {
+	Name() string               // defimpl:"read name"
+	SetName(string)             // defimpl:"set name"
+	Note() string               // defimpl:"read note"
+	SetNote(string)             // defimpl:"set note"
+	Kind() string               // defimpl:"read kind" default:"\"thing\""
+	SetKind(string)             // defimpl:"set kind"
+	Equal(Named) bool           // defimpl:"equal" slots:"name,kind"
+	Hash() uint64               // defimpl:"hash"
+}
+
+// Job is used to test the compare verb.  Its version key can be nil.
+type Job interface {
+	Priority() int              // defimpl:"read priority"
+	SetPriority(int)            // defimpl:"set priority"
+	Version() *Version          // defimpl:"read version"
+	SetVersion(*Version)        // defimpl:"set version"
+	Name() string               // defimpl:"read name"
+	SetName(string)             // defimpl:"set name"
+	Compare(Job) int            // defimpl:"compare" keys:"priority desc,version,name" sort:"SortJobs"
+}
+
+// Version is ordered by its Compare method.
+type Version struct {
+	Major, Minor int
+}
+
+func (v *Version) Compare(o *Version) int {
+	if v.Major != o.Major {
+		return v.Major - o.Major
+	}
+	return v.Minor - o.Minor
+}
+
+// Expr is used to test the (VISITABLE) option.  It has no impl
+// struct of its own, so the ExprVisitor is generated for the
+// interfaces that embed it.
+// (VISITABLE)
+type Expr interface {
+	Accept(ExprVisitor)
+}
+
+// Literal is a member of the Expr family.
+type Literal interface {
+	Expr
+	Value() int          // defimpl:"read value"
+	SetValue(int)        // defimpl:"set value"
+}
+
+// Sum is a member of the Expr family.
+type Sum interface {
+	Expr
+	Operands() []Expr     // defimpl:"read operands"
+	AddOperands(...Expr)  // defimpl:"append operands"
+}
+
+// Ledger is used to test how the (JOURNAL) option undoes and redoes
+// modifications of collection valued slots.
+// (JOURNAL)
+type Ledger interface {
+	Entries() []string               // defimpl:"read entries"
+	AddEntries(...string)            // defimpl:"append entries"
+	InsertEntry(int, string)         // defimpl:"insert entries"
+	RemoveEntryAt(int) string        // defimpl:"removeat entries"
+	ReplaceEntry(int, string) string // defimpl:"replace entries"
+	RemoveEntry(string)              // defimpl:"delete entries"
+	Amounts() []int                  // defimpl:"read amounts"
+	AddAmounts(...int)               // defimpl:"append amounts" order:"cmp.Compare"
+	Stack() []string                 // defimpl:"read stack"
+	Push(...string)                  // defimpl:"push stack"
+	Pop() (string, bool)             // defimpl:"pop stack"
+	AddTags(...string)               // defimpl:"add tags" ordered:"true"
+	RemoveTag(string)                // defimpl:"remove tags"
+	Tags() []string                  // defimpl:"members tags"
+	Mark(string)                     // defimpl:"add marks"
+	Unmark(string)                   // defimpl:"remove marks"
+	Marked(string) bool              // defimpl:"contains marks"
+	SetJournaler(runtime.Journaler)
+}
+
+// Seating is used to test that the range verb infers that a slot with
+// int keys is a map regardless of the order in which its verbs are
+// declared.
+type Seating interface {
+	Seats() iter.Seq2[int, string]   // defimpl:"range seats"
+	SetSeats(map[int]string)         // defimpl:"set seats"
+	SetRows(map[int]string)          // defimpl:"set rows"
+	Rows() iter.Seq2[int, string]    // defimpl:"range rows"
+}
+
+// Tally is used to test that all of the verbs of a (THREADSAFE)
+// implementation can be used concurrently, including those of atomic
+// slots along with the (DIRTY), (JOURNAL) and (SNAPSHOT) options.
+// (THREADSAFE) (DIRTY) (JOURNAL) (SNAPSHOT)
+type Tally interface {
+	IncHits() int64                    // defimpl:"increment hits"
+	Hits() int64                       // defimpl:"read hits"
+	AddEntries(...string)              // defimpl:"append entries"
+	InsertEntry(int, string)           // defimpl:"insert entries"
+	RemoveEntry(string)                // defimpl:"delete entries"
+	RemoveEntryAt(int) string          // defimpl:"removeat entries"
+	ReplaceEntry(int, string) string   // defimpl:"replace entries"
+	Entry(int) string                  // defimpl:"index entries"
+	EntryCount() int                   // defimpl:"length entries"
+	DoEntries(func(string) bool)       // defimpl:"iterate entries"
+	AllEntries() iter.Seq[string]      // defimpl:"range entries"
+	FindEntry(func(string) bool) (string, bool)  // defimpl:"find entries"
+	CountEntries(func(string) bool) int          // defimpl:"count entries"
+	Push(...string)                    // defimpl:"push stack"
+	Pop() (string, bool)               // defimpl:"pop stack"
+	Peek() (string, bool)              // defimpl:"peek stack"
+	Enqueue(string)                    // defimpl:"enqueue pending"
+	Dequeue() (string, bool)           // defimpl:"dequeue pending"
+	Mark(string)                       // defimpl:"add marks"
+	Unmark(string)                     // defimpl:"remove marks"
+	Marked(string) bool                // defimpl:"contains marks"
+	Marks() []string                   // defimpl:"members marks"
+	SetScores(map[string]int)          // defimpl:"set scores"
+	Scores() iter.Seq2[string, int]    // defimpl:"range scores"
+	DirtySlots() []string
+	ClearDirty()
+	SetJournaler(runtime.Journaler)
+	Snapshot() any
+	Restore(any)
+}
+
+// Quota is used to test the default option of an atomic slot.
+// (THREADSAFE) (SNAPSHOT)
+type Quota interface {
+	IncUses() int64             // defimpl:"increment uses" default:"10"
+	Uses() int64                // defimpl:"read uses"
+	Snapshot() any
+	Restore(any)
+}
+
+// Clicker is used to test a counter whose verbs don't mention its
+// type.
+type Clicker interface {
+	Click()                     // defimpl:"increment clicks"
+	Unclick()                   // defimpl:"decrement clicks"
+	String() string             // defimpl:"string"
+}
+
+// Pile is used to test the push, pop and peek verbs.
+type Pile interface {
+	Push(...string)          // defimpl:"push items"
+	Pop() (string, bool)     // defimpl:"pop items"
+	MustPop() string         // defimpl:"pop items"
+	Top() (string, bool)     // defimpl:"peek items"
+	Height() int             // defimpl:"length items"
+}
+
+// Backlog is used to test the enqueue and dequeue verbs.  It has the
+// (JOURNAL) option so that queue valued slots get copied.
+// (JOURNAL)
+type Backlog interface {
+	Enqueue(int)             // defimpl:"enqueue tasks"
+	Dequeue() (int, bool)    // defimpl:"dequeue tasks"
+	Next() (int, bool)       // defimpl:"peek tasks"
+	Pending() int            // defimpl:"length tasks"
+}
+
 
 /*
 type Base1 interface {
@@ -1,6 +1,11 @@
 package test
 
+import "encoding/json"
+import "fmt"
+import "iter"
 import "reflect"
+import "strings"
+import "sync"
 import "testing"
 import "defimpl/runtime"
 
@@ -45,10 +50,63 @@ func TestSliceValued(t *testing.T) {
 		})
 	}
 	test_iterate([]Thing{thing2, thing3})
+	test_range := func(expect []Thing) {
+		got := []Thing{}
+		for thing := range thing1.AllRelated() {
+			got = append(got, thing)
+		}
+		if !reflect.DeepEqual(got, expect) {
+			t.Errorf("range: got %v, want %v", got, expect)
+		}
+		for i, thing := range thing1.EnumerateRelated() {
+			if thing != expect[i] {
+				t.Errorf("range %d: got %#v, want %#v", i, thing, expect[i])
+			}
+			break
+		}
+	}
+	test_range([]Thing{thing2, thing3})
 	thing1.RemoveRelated(thing2)
 	test_iterate([]Thing{thing3})
 }
 
+func TestPositional(t *testing.T) {
+	things := []Thing{}
+	for _, name := range []string{ "a", "b", "c", "d" } {
+		thing := NewThing()
+		thing.SetName(name)
+		things = append(things, thing)
+	}
+	thing := NewThing()
+	check := func(when string, expect ...Thing) {
+		got := []Thing{}
+		for r := range thing.AllRelated() {
+			got = append(got, r)
+		}
+		if !reflect.DeepEqual(got, expect) {
+			t.Errorf("%s: got %v, want %v", when, got, expect)
+		}
+	}
+	thing.InsertRelated(0, things[1])
+	thing.InsertRelated(0, things[0])
+	thing.InsertRelated(2, things[2])
+	check("after insert", things[0], things[1], things[2])
+	if got := thing.ReplaceRelated(1, things[3]); got != things[1] {
+		t.Errorf("ReplaceRelated returned %v", got)
+	}
+	check("after replace", things[0], things[3], things[2])
+	if got := thing.RemoveRelatedAt(0); got != things[0] {
+		t.Errorf("RemoveRelatedAt returned %v", got)
+	}
+	check("after remove", things[3], things[2])
+	defer func() {
+		if recover() == nil {
+			t.Errorf("InsertRelated out of range didn't panic")
+		}
+	}()
+	thing.InsertRelated(3, things[0])
+}
+
 func TestInheritance(t *testing.T) {
 }
 
@@ -59,14 +117,20 @@ func TestRuntime(t *testing.T) {
 	if ty.Kind() != reflect.Ptr || ty.Elem().Kind() != reflect.Struct {
 		t.Errorf("ThingImpl is %v, not pointer to struct", ty)
 	}
-	if want, got := ty, runtime.ImplFor(ty); got != want {
-		t.Errorf("ImplFor of Impl type failed: want %v, got %v", want, got)
+	if got, err := runtime.ImplFor(ty); err != nil || got != ty {
+		t.Errorf("ImplFor of Impl type failed: want %v, got %v, %v", ty, got, err)
+	}
+	i, err := runtime.InterfaceFor(ty)
+	if err != nil {
+		t.Fatalf("InterfaceFor of Impl type failed: %s", err)
 	}
-	i := runtime.InterfaceFor(ty)
 	if want, got := reflect.Interface, i.Kind(); want != got {
 		t.Errorf("InterfaceFor of Impl type failed: want %v, got %v", want, got)
 	}
-	iimpl := runtime.ImplFor(i)
+	iimpl, err := runtime.ImplFor(i)
+	if err != nil {
+		t.Fatalf("ImplFor of interface type failed: %s", err)
+	}
 	if want, got := reflect.Ptr, iimpl.Kind(); want != got {
 		t.Errorf("ImplFor of interface type failed: want %v, got %v", want, got)
 	}
@@ -75,3 +139,1233 @@ func TestRuntime(t *testing.T) {
 	}
 }
 
+
+func TestDirty(t *testing.T) {
+	r := Record(&RecordImpl{})
+	if got := r.DirtySlots(); len(got) != 0 {
+		t.Errorf("New Record has dirty slots %v", got)
+	}
+	r.SetTitle("foo")
+	if want, got := []string{"title"}, r.DirtySlots(); !reflect.DeepEqual(want, got) {
+		t.Errorf("After SetTitle: want %v, got %v", want, got)
+	}
+	r.ClearDirty()
+	r.RemoveTag("bar")
+	if got := r.DirtySlots(); len(got) != 0 {
+		t.Errorf("Deleting an absent item dirtied %v", got)
+	}
+	r.AddTags("bar")
+	if want, got := []string{"tags"}, r.DirtySlots(); !reflect.DeepEqual(want, got) {
+		t.Errorf("After AddTags: want %v, got %v", want, got)
+	}
+	l := Labeled(&LabeledImpl{})
+	l.AddLabel("a")
+	l.AddKeywords("b", "c")
+	l.ClearDirty()
+	l.AddLabel("a")
+	l.AddKeywords("c", "b")
+	l.RemoveLabel("z")
+	l.RemoveKeyword("z")
+	if got := l.DirtySlots(); len(got) != 0 {
+		t.Errorf("Adding present and removing absent members dirtied %v", got)
+	}
+	l.AddKeywords("c", "d")
+	if want, got := []string{"keywords"}, l.DirtySlots(); !reflect.DeepEqual(want, got) {
+		t.Errorf("After AddKeywords: want %v, got %v", want, got)
+	}
+}
+
+func TestJournal(t *testing.T) {
+	j := &runtime.Journal{}
+	doc1 := &DocumentImpl{}
+	doc2 := &DocumentImpl{}
+	doc1.SetJournaler(j)
+	doc2.SetJournaler(j)
+	// doc3 has a history of its own.
+	doc3 := &DocumentImpl{}
+	doc3.SetJournaler(&runtime.Journal{})
+	doc3.SetText("unrelated")
+	doc1.AddLines("a", "b", "c")
+	j.Begin()
+	doc1.RemoveLine("b")
+	doc1.SetText("changed")
+	doc2.SetText("also changed")
+	j.Commit()
+	check := func(when string, count int, text1, text2 string) {
+		if got := doc1.LineCount(); got != count {
+			t.Errorf("%s: want %d lines, got %d", when, count, got)
+		}
+		if got := doc1.Text(); got != text1 {
+			t.Errorf("%s: doc1 text: want %q, got %q", when, text1, got)
+		}
+		if got := doc2.Text(); got != text2 {
+			t.Errorf("%s: doc2 text: want %q, got %q", when, text2, got)
+		}
+	}
+	check("after commit", 2, "changed", "also changed")
+	if !j.Undo() {
+		t.Fatalf("Nothing to undo")
+	}
+	check("after undo", 3, "", "")
+	if want, got := "b", doc1.Line(1); got != want {
+		t.Errorf("after undo: want line %q, got %q", want, got)
+	}
+	j.Undo()
+	check("after second undo", 0, "", "")
+	if j.Undo() {
+		t.Errorf("Undo should have had nothing to undo")
+	}
+	j.Redo()
+	j.Redo()
+	check("after redo", 2, "changed", "also changed")
+	if j.CanRedo() {
+		t.Errorf("Nothing should remain to be redone")
+	}
+	if want, got := "unrelated", doc3.Text(); got != want {
+		t.Errorf("doc3: want %q, got %q", want, got)
+	}
+}
+
+func TestJournalCollections(t *testing.T) {
+	j := &runtime.Journal{}
+	l := Ledger(&LedgerImpl{})
+	l.SetJournaler(j)
+	state := func() string {
+		return fmt.Sprint(l.Entries(), l.Amounts(), l.Stack(), l.Tags(),
+			l.Marked("a"), l.Marked("b"))
+	}
+	states := []string{ state() }
+	for _, modify := range []func(){
+		func() { l.AddEntries("a", "b") },
+		func() { l.InsertEntry(1, "c") },
+		func() { l.ReplaceEntry(0, "d") },
+		func() { l.RemoveEntryAt(1) },
+		func() { l.RemoveEntry("d") },
+		func() { l.AddAmounts(5, 1, 3) },
+		func() { l.AddAmounts(2, 5) },
+		func() { l.Push("x", "y") },
+		func() { l.Pop() },
+		func() { l.AddTags("p", "q", "r") },
+		func() { l.RemoveTag("q") },
+		func() { l.AddTags("q", "p") },
+		func() { l.Mark("a") },
+		func() { l.Mark("b") },
+		func() { l.Unmark("a") },
+	} {
+		modify()
+		states = append(states, state())
+	}
+	for i := len(states) - 2; i >= 0; i-- {
+		if !j.Undo() {
+			t.Fatalf("Nothing to undo at %d", i)
+		}
+		if got := state(); got != states[i] {
+			t.Errorf("Undo to %d: want %s, got %s", i, states[i], got)
+		}
+	}
+	for i := 1; i < len(states); i++ {
+		j.Redo()
+		if got := state(); got != states[i] {
+			t.Errorf("Redo to %d: want %s, got %s", i, states[i], got)
+		}
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	doc := Document(&DocumentImpl{})
+	doc.SetText("original")
+	doc.AddLines("a", "b")
+	snapshot := doc.Snapshot()
+	doc.SetText("changed")
+	doc.RemoveLine("a")
+	doc.AddLines("c", "d")
+	doc.Restore(snapshot)
+	if want, got := "original", doc.Text(); got != want {
+		t.Errorf("Restored text: want %q, got %q", want, got)
+	}
+	if want, got := 2, doc.LineCount(); got != want {
+		t.Fatalf("Restored line count: want %d, got %d", want, got)
+	}
+	if want, got := "a", doc.Line(0); got != want {
+		t.Errorf("Restored line 0: want %q, got %q", want, got)
+	}
+	// The snapshot shouldn't share storage with the object.
+	doc.RemoveLine("a")
+	doc.Restore(snapshot)
+	if want, got := "a", doc.Line(0); got != want {
+		t.Errorf("Restored again line 0: want %q, got %q", want, got)
+	}
+}
+
+func TestView(t *testing.T) {
+	r := Record(&RecordImpl{})
+	r.SetTitle("title")
+	r.AddTags("a", "b")
+	view := NewRecordView(r)
+	if _, ok := view.(Record); ok {
+		t.Errorf("A RecordView shouldn't be a Record")
+	}
+	if want, got := "title", view.Title(); got != want {
+		t.Errorf("View Title: want %q, got %q", want, got)
+	}
+	if want, got := 2, view.TagCount(); got != want {
+		t.Errorf("View TagCount: want %d, got %d", want, got)
+	}
+	count := 0
+	view.DoTags(func(string) bool {
+		count += 1
+		return true
+	})
+	if want, got := 2, count; got != want {
+		t.Errorf("View DoTags: want %d calls, got %d", want, got)
+	}
+	view.Tags()[0] = "changed"
+	if want, got := "a", r.Tags()[0]; got != want {
+		t.Errorf("Modifying the view's Tags changed the Record: want %q, got %q", want, got)
+	}
+	r.SetAttributes(map[string]int{ "a": 1 })
+	view.AttributeMap()["a"] = 2
+	if want, got := 1, r.AttributeMap()["a"]; got != want {
+		t.Errorf("Modifying the view's AttributeMap changed the Record: want %d, got %d", want, got)
+	}
+	vt := reflect.TypeOf((*RecordView)(nil)).Elem()
+	if _, ok := vt.MethodByName("SetTitle"); ok {
+		t.Errorf("RecordView shouldn't have SetTitle")
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	r := Record(&RecordImpl{})
+	r.AddTags("apple", "banana", "avocado")
+	startsWithA := func(s string) bool { return strings.HasPrefix(s, "a") }
+	startsWithZ := func(s string) bool { return strings.HasPrefix(s, "z") }
+	if tag, found := r.FindTag(startsWithA); !found || tag != "apple" {
+		t.Errorf("FindTag: got %q, %v", tag, found)
+	}
+	if _, found := r.FindTag(startsWithZ); found {
+		t.Errorf("FindTag found a tag starting with z")
+	}
+	if want, got := []string{ "apple", "avocado" }, r.FilterTags(startsWithA); !reflect.DeepEqual(want, got) {
+		t.Errorf("FilterTags: want %v, got %v", want, got)
+	}
+	if want, got := 2, r.CountTags(startsWithA); got != want {
+		t.Errorf("CountTags: want %d, got %d", want, got)
+	}
+	if !r.AnyTag(startsWithA) || r.AnyTag(startsWithZ) {
+		t.Errorf("AnyTag")
+	}
+	if r.AllTags(startsWithA) || !r.AllTags(func(string) bool { return true }) {
+		t.Errorf("AllTags")
+	}
+	// The (VIEW) option includes the predicate verbs since they
+	// don't mutate.
+	if want, got := 2, NewRecordView(r).CountTags(startsWithA); got != want {
+		t.Errorf("View CountTags: want %d, got %d", want, got)
+	}
+}
+
+func TestRangeMap(t *testing.T) {
+	r := Record(&RecordImpl{})
+	r.SetAttributes(map[string]int{ "a": 1, "b": 2 })
+	got := map[string]int{}
+	for k, v := range r.Attributes() {
+		got[k] = v
+	}
+	if want := map[string]int{ "a": 1, "b": 2 }; !reflect.DeepEqual(got, want) {
+		t.Errorf("range over map: got %v, want %v", got, want)
+	}
+}
+
+func TestRangeIntKeys(t *testing.T) {
+	s := Seating(&SeatingImpl{})
+	want := map[int]string{ 3: "c", 7: "g" }
+	s.SetSeats(want)
+	s.SetRows(want)
+	for name, seq := range map[string]iter.Seq2[int, string]{
+		"Seats": s.Seats(),
+		"Rows": s.Rows(),
+	} {
+		got := map[int]string{}
+		for k, v := range seq {
+			got[k] = v
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	l := Labeled(&LabeledImpl{})
+	if l.HasLabel("a") {
+		t.Errorf("Empty set has a member")
+	}
+	l.AddLabel("a")
+	l.AddLabel("b")
+	l.AddLabel("a")
+	if want, got := 2, l.LabelCount(); got != want {
+		t.Errorf("LabelCount: want %d, got %d", want, got)
+	}
+	if !l.HasLabel("a") || !l.HasLabel("b") {
+		t.Errorf("Labels: got %v", l.Labels())
+	}
+	snapshot := l.Snapshot()
+	l.RemoveLabel("a")
+	if want, got := []string{"b"}, l.Labels(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Labels after remove: want %v, got %v", want, got)
+	}
+	l.Restore(snapshot)
+	if !l.HasLabel("a") {
+		t.Errorf("Restore didn't restore the set")
+	}
+}
+
+func TestOrderedSet(t *testing.T) {
+	l := Labeled(&LabeledImpl{})
+	l.AddKeywords("c", "a", "b", "a")
+	if want, got := []string{"c", "a", "b"}, l.Keywords(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Keywords: want %v, got %v", want, got)
+	}
+	if want, got := 3, l.KeywordCount(); got != want {
+		t.Errorf("KeywordCount: want %d, got %d", want, got)
+	}
+	snapshot := l.Snapshot()
+	l.RemoveKeyword("a")
+	if l.HasKeyword("a") {
+		t.Errorf("HasKeyword after RemoveKeyword")
+	}
+	if want, got := []string{"c", "b"}, l.Keywords(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Keywords after remove: want %v, got %v", want, got)
+	}
+	l.Restore(snapshot)
+	if want, got := []string{"c", "a", "b"}, l.Keywords(); !reflect.DeepEqual(want, got) {
+		t.Errorf("Restored keywords: want %v, got %v", want, got)
+	}
+}
+
+func TestSorted(t *testing.T) {
+	s := Schedule(&ScheduleImpl{})
+	s.AddEvents(Event{ 3, "c" }, Event{ 1, "a" })
+	s.AddEvents(Event{ 2, "b" }, Event{ 1, "a2" })
+	want := []string{ "a", "a2", "b", "c" }
+	if got := s.EventCount(); got != len(want) {
+		t.Fatalf("EventCount: want %d, got %d", len(want), got)
+	}
+	for i, name := range want {
+		if got := s.Event(i).Name; got != name {
+			t.Errorf("Event %d: want %q, got %q", i, name, got)
+		}
+	}
+	if e, found := s.FindEvent(Event{ Time: 2 }); !found || e.Name != "b" {
+		t.Errorf("FindEvent 2: got %v, %v", e, found)
+	}
+	if i, found := s.EventIndex(Event{ Time: 4 }); found || i != 4 {
+		t.Errorf("EventIndex 4: got %d, %v", i, found)
+	}
+	if i, found := s.EventIndex(Event{ Time: 1 }); !found || i != 0 {
+		t.Errorf("EventIndex 1: got %d, %v", i, found)
+	}
+	s.AddPriorities(5, 1, 3)
+	for i, p := range []int{ 1, 3, 5 } {
+		if got := s.Priority(i); got != p {
+			t.Errorf("Priority %d: want %d, got %d", i, p, got)
+		}
+	}
+}
+
+func TestCopy(t *testing.T) {
+	r := Roster(&RosterImpl{})
+	names := []string{ "a", "b" }
+	r.SetNames(names)
+	names[0] = "changed"
+	if want, got := "a", r.Names()[0]; got != want {
+		t.Errorf("SetNames didn't copy: want %q, got %q", want, got)
+	}
+	r.Names()[1] = "changed"
+	if want, got := "b", r.Names()[1]; got != want {
+		t.Errorf("Names didn't copy: want %q, got %q", want, got)
+	}
+	r.SetScores(map[string]int{ "a": 1 })
+	r.Scores()["a"] = 2
+	if want, got := 1, r.Scores()["a"]; got != want {
+		t.Errorf("Scores didn't copy: want %d, got %d", want, got)
+	}
+}
+
+func TestCounter(t *testing.T) {
+	s := Stats(&StatsImpl{})
+	s.IncHits()
+	s.IncHits()
+	if want, got := 1, s.DecHits(); got != want {
+		t.Errorf("DecHits: want %d, got %d", want, got)
+	}
+	if want, got := 1, s.Hits(); got != want {
+		t.Errorf("Hits: want %d, got %d", want, got)
+	}
+	s.AddBytes(10)
+	if want, got := int64(15), s.AddBytes(5); got != want {
+		t.Errorf("AddBytes: want %d, got %d", want, got)
+	}
+	s.AddWeight(0.5)
+	if want, got := 0.5, s.Weight(); got != want {
+		t.Errorf("Weight: want %v, got %v", want, got)
+	}
+}
+
+func TestThreadSafeCounter(t *testing.T) {
+	s := SafeStats(&SafeStatsImpl{})
+	if _, ok := reflect.TypeOf(SafeStatsImpl{}).FieldByName("hits"); !ok {
+		t.Fatalf("No hits field")
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s.IncHits()
+				s.AddWeight(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if want, got := int64(1000), s.Hits(); got != want {
+		t.Errorf("Hits: want %d, got %d", want, got)
+	}
+	if want, got := 1000.0, s.Weight(); got != want {
+		t.Errorf("Weight: want %v, got %v", want, got)
+	}
+	s.DecHits()
+	s.SetHits(s.Hits() + 1)
+	if want, got := int64(1000), s.Hits(); got != want {
+		t.Errorf("Hits after DecHits and SetHits: want %d, got %d", want, got)
+	}
+	s.IncUnsigned()
+	if want, got := uint32(0), s.DecUnsigned(); got != want {
+		t.Errorf("DecUnsigned: want %d, got %d", want, got)
+	}
+}
+
+func TestThreadSafeVerbs(t *testing.T) {
+	x := &TallyImpl{}
+	x.SetJournaler(&runtime.Journal{})
+	x.AddEntries("seed")
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				e := fmt.Sprintf("%d.%d", i, j)
+				x.IncHits()
+				x.AddEntries(e)
+				x.InsertEntry(0, e)
+				x.ReplaceEntry(0, e)
+				x.RemoveEntryAt(0)
+				x.Entry(0)
+				// The functions of the callback verbs can use the
+				// object.
+				x.DoEntries(func(string) bool { return x.EntryCount() < 0 })
+				for range x.AllEntries() {
+					x.Hits()
+					break
+				}
+				x.FindEntry(func(v string) bool { return v == e })
+				x.CountEntries(func(string) bool { return true })
+				x.RemoveEntry(e)
+				x.Push(e)
+				x.Peek()
+				x.Pop()
+				x.Enqueue(e)
+				x.Dequeue()
+				x.Mark(e)
+				x.Marked(e)
+				x.Marks()
+				x.Unmark(e)
+				x.SetScores(map[string]int{ e: j })
+				for range x.Scores() {
+				}
+				x.DirtySlots()
+				x.Restore(x.Snapshot())
+			}
+		}()
+	}
+	wg.Wait()
+	if want, got := int64(400), x.Hits(); got != want {
+		t.Errorf("Hits: want %d, got %d", want, got)
+	}
+	if got := x.Marks(); len(got) != 0 {
+		t.Errorf("Marks: want none, got %v", got)
+	}
+}
+
+func TestThreadSafeDefault(t *testing.T) {
+	q := Quota(&QuotaImpl{})
+	fresh := q.Snapshot()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Whichever of these comes first gives uses its
+			// default value.
+			q.Uses()
+			q.IncUses()
+		}()
+	}
+	wg.Wait()
+	if want, got := int64(18), q.Uses(); got != want {
+		t.Errorf("Uses: want %d, got %d", want, got)
+	}
+	// Restoring the snapshot of a fresh Quota reinstates the
+	// default.
+	q.Restore(fresh)
+	if want, got := int64(10), q.Uses(); got != want {
+		t.Errorf("Uses after Restore: want %d, got %d", want, got)
+	}
+}
+
+func TestUntypedCounter(t *testing.T) {
+	c := &ClickerImpl{}
+	c.Click()
+	c.Click()
+	c.Unclick()
+	if want, got := "ClickerImpl{clicks: 1}", c.String(); got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestFlags(t *testing.T) {
+	s := Status(&StatusImpl{})
+	s.Enable()
+	if !s.IsEnabled() {
+		t.Errorf("Enable")
+	}
+	if s.ToggleEnabled() || s.IsEnabled() {
+		t.Errorf("ToggleEnabled")
+	}
+	s.ToggleEnabled()
+	s.Disable()
+	if s.IsEnabled() {
+		t.Errorf("Disable")
+	}
+	s.SetVisible()
+	s.Lock()
+	if !s.IsVisible() || !s.IsLocked() {
+		t.Errorf("SetVisible and Lock: flags %b", s.Flags())
+	}
+	s.ClearVisible()
+	if s.IsVisible() || !s.IsLocked() {
+		t.Errorf("ClearVisible: flags %b", s.Flags())
+	}
+	if want, got := FlagLocked, s.Flags(); got != want {
+		t.Errorf("Flags: want %b, got %b", want, got)
+	}
+}
+
+func TestInitialValues(t *testing.T) {
+	s := Settings(&SettingsImpl{})
+	if want, got := "unnamed", s.Name(); got != want {
+		t.Errorf("Name: want %q, got %q", want, got)
+	}
+	s.SetName("")
+	if want, got := "", s.Name(); got != want {
+		t.Errorf("Name after SetName: want %q, got %q", want, got)
+	}
+	if want, got := 4, s.IncRetries(); got != want {
+		t.Errorf("IncRetries: want %d, got %d", want, got)
+	}
+	if s.Limits() == nil {
+		t.Errorf("Limits is nil")
+	}
+	if s.Paths() == nil {
+		t.Errorf("Paths is nil")
+	}
+	if want, got := 1, s.CacheSize(); got != want {
+		t.Errorf("CacheSize: want %d, got %d", want, got)
+	}
+	if want, got := "b", s.Cache()["a"]; got != want {
+		t.Errorf("Cache: want %q, got %q", want, got)
+	}
+}
+
+func TestPresence(t *testing.T) {
+	p := Profile(&ProfileImpl{})
+	if p.HasAge() || p.NicknameIsSet() {
+		t.Errorf("New Profile has slots set")
+	}
+	p.SetAge(0)
+	if !p.HasAge() {
+		t.Errorf("HasAge after SetAge(0)")
+	}
+	p.UnsetAge()
+	if p.HasAge() {
+		t.Errorf("HasAge after UnsetAge")
+	}
+	p.SetNickname("")
+	if !p.NicknameIsSet() || p.Nickname() != "" {
+		t.Errorf("After SetNickname: %v %q", p.NicknameIsSet(), p.Nickname())
+	}
+	p.ClearNickname()
+	if want, got := "none", p.Nickname(); got != want {
+		t.Errorf("Nickname after ClearNickname: want %q, got %q", want, got)
+	}
+	if p.NicknameIsSet() {
+		t.Errorf("NicknameIsSet after ClearNickname")
+	}
+}
+
+func TestPresenceJournal(t *testing.T) {
+	j := &runtime.Journal{}
+	p := &ProfileImpl{}
+	p.SetJournaler(j)
+	p.SetAge(3)
+	j.Undo()
+	if p.HasAge() {
+		t.Errorf("HasAge after undoing SetAge")
+	}
+	j.Redo()
+	if !p.HasAge() || p.Age() != 3 {
+		t.Errorf("After redoing SetAge: %v %d", p.HasAge(), p.Age())
+	}
+	p.SetNickname("nick")
+	p.ClearNickname()
+	j.Undo()
+	if want, got := "nick", p.Nickname(); got != want || !p.NicknameIsSet() {
+		t.Errorf("Nickname after undoing ClearNickname: want %q, got %q, %v",
+			want, got, p.NicknameIsSet())
+	}
+	snapshot := p.Snapshot()
+	p.UnsetAge()
+	p.ClearNickname()
+	p.Restore(snapshot)
+	if !p.HasAge() || !p.NicknameIsSet() || p.Nickname() != "nick" {
+		t.Errorf("After Restore: %v %v %q", p.HasAge(), p.NicknameIsSet(), p.Nickname())
+	}
+	p.Restore((&ProfileImpl{}).Snapshot())
+	if p.HasAge() || p.NicknameIsSet() || p.Nickname() != "none" {
+		t.Errorf("After restoring a new Profile: %v %v %q", p.HasAge(), p.NicknameIsSet(), p.Nickname())
+	}
+	// Verbs other than set also set a slot.
+	p.AddTags("a")
+	p.IncLogins()
+	if !p.HasTags() || !p.LoginsIsSet() {
+		t.Errorf("After AddTags and IncLogins: %v %v", p.HasTags(), p.LoginsIsSet())
+	}
+	j.Undo()
+	j.Undo()
+	if p.HasTags() || p.LoginsIsSet() {
+		t.Errorf("After undoing AddTags and IncLogins: %v %v", p.HasTags(), p.LoginsIsSet())
+	}
+	j.Redo()
+	if !p.HasTags() || len(p.Tags()) != 1 {
+		t.Errorf("After redoing AddTags: %v %v", p.HasTags(), p.Tags())
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	p := Profile(&ProfileImpl{})
+	if want, got := `ProfileImpl{email: ""}`, p.String(); got != want {
+		t.Errorf("String: want %s, got %s", want, got)
+	}
+	p.SetAge(0)
+	p.SetNickname("nick")
+	if want, got := `ProfileImpl{age: 0, nickname: "nick", email: ""}`, p.String(); got != want {
+		t.Errorf("String: want %s, got %s", want, got)
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("Marshal: %s", err)
+	}
+	if want, got := `{"age":0,"email":"","nickname":"nick"}`, string(b); got != want {
+		t.Errorf("Marshal: want %s, got %s", want, got)
+	}
+	p.UnsetAge()
+	b, _ = json.Marshal(p)
+	if want, got := `{"email":"","nickname":"nick"}`, string(b); got != want {
+		t.Errorf("Marshal after UnsetAge: want %s, got %s", want, got)
+	}
+	p.AddTags("a")
+	p.IncLogins()
+	if want, got := `ProfileImpl{nickname: "nick", email: "", tags: [a], logins: 1}`, p.String(); got != want {
+		t.Errorf("String: want %s, got %s", want, got)
+	}
+}
+
+func TestID(t *testing.T) {
+	previous := runtime.EnableRegistry(true)
+	defer runtime.EnableRegistry(previous)
+	e1 := Entity(&EntityImpl{})
+	e2 := Entity(&EntityImpl{})
+	id1 := e1.ID()
+	if id1 == 0 {
+		t.Errorf("ID is zero")
+	}
+	if id1 == e2.ID() {
+		t.Errorf("IDs aren't unique")
+	}
+	if e1.ID() != id1 {
+		t.Errorf("ID isn't stable")
+	}
+	if got := runtime.LookupID(id1); got != e1 {
+		t.Errorf("LookupID: want %v, got %v", e1, got)
+	}
+	if got := runtime.LookupID(0); got != nil {
+		t.Errorf("LookupID(0): got %v", got)
+	}
+	// An object that was assigned its identifier while the
+	// registry was off is registered when next asked for it.
+	runtime.EnableRegistry(false)
+	e3 := Entity(&EntityImpl{})
+	id3 := e3.ID()
+	runtime.EnableRegistry(true)
+	if got := runtime.LookupID(id3); got != nil {
+		t.Errorf("LookupID before ID with the registry on: got %v", got)
+	}
+	e3.ID()
+	if got := runtime.LookupID(id3); got != e3 {
+		t.Errorf("LookupID after enabling the registry: want %v, got %v", e3, got)
+	}
+}
+
+func TestChildren(t *testing.T) {
+	node := func(label string) TreeNode {
+		n := &TreeNodeImpl{}
+		n.SetLabel(label)
+		return n
+	}
+	labels := func(nodes []TreeNode) string {
+		s := []string{}
+		for _, n := range nodes {
+			s = append(s, n.Label())
+		}
+		return strings.Join(s, " ")
+	}
+	a, b, c, d := node("a"), node("b"), node("c"), node("d")
+	a.AddChild(b, c)
+	b.AddChild(d)
+	if d.Parent() != b || b.Parent() != a || a.Parent() != nil {
+		t.Errorf("parents not set")
+	}
+	if d.Root() != a || a.Root() != a {
+		t.Errorf("Root: wrong root")
+	}
+	if got := labels(d.Ancestors()); got != "b a" {
+		t.Errorf("Ancestors: got %q", got)
+	}
+	walked := []TreeNode{}
+	a.Walk(func(n TreeNode) bool {
+		walked = append(walked, n)
+		return n != b
+	})
+	if got := labels(walked); got != "a b c" {
+		t.Errorf("Walk: got %q", got)
+	}
+	// Moving d to c removes it from b.
+	c.InsertChild(0, d)
+	if d.Parent() != c || len(b.Children()) != 0 {
+		t.Errorf("InsertChild: d not moved from b to c")
+	}
+	// Moving c to the end of a's children.
+	a.AddChild(c)
+	if got := labels(a.Children()); got != "b c" {
+		t.Errorf("AddChild: re-adding a child: got %q", got)
+	}
+	a.RemoveChild(b)
+	if b.Parent() != nil || labels(a.Children()) != "c" {
+		t.Errorf("RemoveChild: b not removed")
+	}
+	if removed := c.RemoveChildAt(0); removed != d || d.Parent() != nil {
+		t.Errorf("RemoveChildAt: d not removed")
+	}
+	a.AddChild(b)
+	// An index that's out of range leaves b where it was.
+	func() {
+		defer func() { recover() }()
+		c.InsertChild(5, b)
+	}()
+	if b.Parent() != a || labels(a.Children()) != "c b" {
+		t.Errorf("InsertChild out of range moved b")
+	}
+	// Moving c to the end of its own parent's children.
+	a.InsertChild(2, c)
+	if got := labels(a.Children()); got != "b c" || c.Parent() != a {
+		t.Errorf("InsertChild: moving within a parent: got %q", got)
+	}
+	if old := a.ReplaceChild(0, d); old != b || b.Parent() != nil || d.Parent() != a {
+		t.Errorf("ReplaceChild: parents not maintained")
+	}
+	// Replacing c with d, which is already a child of a, ahead of c.
+	a.ReplaceChild(1, d)
+	if got := labels(a.Children()); got != "d" || c.Parent() != nil || d.Parent() != a {
+		t.Errorf("ReplaceChild: moving within a parent: got %q", got)
+	}
+}
+
+func TestListeners(t *testing.T) {
+	b := &ButtonImpl{}
+	got := []string{}
+	var cancelB func()
+	cancelA := b.OnClick(func(n int) {
+		got = append(got, fmt.Sprintf("a%d", n))
+		// Removing a handler while firing prevents it from being
+		// called.
+		cancelB()
+	})
+	cancelB = b.OnClick(func(n int) {
+		got = append(got, fmt.Sprintf("b%d", n))
+	})
+	b.Click(1)
+	cancelA()
+	// Cancelling twice is harmless.
+	cancelA()
+	b.Click(2)
+	if strings.Join(got, " ") != "a1" {
+		t.Errorf("Click: got %v", got)
+	}
+	logged := ""
+	b.OnLog(func(format string, args ...any) {
+		logged = fmt.Sprintf(format, args...)
+	})
+	b.Log("%d %s", 3, "x")
+	if logged != "3 x" {
+		t.Errorf("Log: got %q", logged)
+	}
+}
+
+func TestChannel(t *testing.T) {
+	m := &MailboxImpl{}
+	// The buffer holds both messages.
+	m.Send("a")
+	m.Send("b")
+	if v, ok := m.Receive(); v != "a" || !ok {
+		t.Errorf("Receive: got %q, %v", v, ok)
+	}
+	m.Close()
+	got := []string{}
+	for v := range m.Inbox() {
+		got = append(got, v)
+	}
+	if strings.Join(got, " ") != "b" {
+		t.Errorf("Inbox: got %v", got)
+	}
+	if v, ok := m.Receive(); v != "" || ok {
+		t.Errorf("Receive after Close: got %q, %v", v, ok)
+	}
+}
+
+func TestChannelConcurrentFirstUse(t *testing.T) {
+	// Mailbox doesn't have the (THREADSAFE) option, but the sender
+	// and receiver can still be the first to use the channel at the
+	// same time.
+	m := &MailboxImpl{}
+	done := make(chan string)
+	go func() {
+		v, _ := m.Receive()
+		done <- v
+	}()
+	m.Send("a")
+	if v := <-done; v != "a" {
+		t.Errorf("Receive: got %q", v)
+	}
+	// The init function is called just once, however many methods
+	// use the channel first at the same time, and the channel that it
+	// makes is used rather than another.
+	noticesMade.Store(0)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			m.Notify(i)
+		}()
+		go func() {
+			defer wg.Done()
+			m.Notices()
+		}()
+	}
+	wg.Wait()
+	if got := noticesMade.Load(); got != 1 {
+		t.Errorf("newNotices called %d times", got)
+	}
+	if got := len(m.Notices()); got != 4 {
+		t.Errorf("got %d notices buffered", got)
+	}
+}
+
+func TestComputed(t *testing.T) {
+	r := &RectangleImpl{}
+	r.SetWidth(2)
+	r.SetHeight(3)
+	computations = 0
+	if got := r.Area(); got != 6 {
+		t.Errorf("Area: got %g", got)
+	}
+	r.Area()
+	if computations != 1 {
+		t.Errorf("Area computed %d times, want 1", computations)
+	}
+	if got := r.Label(); got != "area 6" {
+		t.Errorf("Label: got %q", got)
+	}
+	// Setting a slot that area depends on invalidates both area and
+	// label, which depends on area.
+	r.SetWidth(5)
+	if got := r.Label(); got != "area 15" {
+		t.Errorf("Label after SetWidth: got %q", got)
+	}
+	if computations != 2 {
+		t.Errorf("Area computed %d times, want 2", computations)
+	}
+}
+
+func TestComputedUndo(t *testing.T) {
+	j := &runtime.Journal{}
+	r := &RectangleImpl{}
+	r.SetJournaler(j)
+	r.SetWidth(1)
+	r.SetHeight(2)
+	r.SetWidth(5)
+	if got := r.Area(); got != 10 {
+		t.Errorf("Area: got %g", got)
+	}
+	// Undoing and redoing a modification of width invalidates area
+	// and label just as modifying it does.
+	j.Undo()
+	if got := r.Area(); got != 2 {
+		t.Errorf("Area after Undo: got %g", got)
+	}
+	if got := r.Label(); got != "area 2" {
+		t.Errorf("Label after Undo: got %q", got)
+	}
+	j.Redo()
+	if got := r.Area(); got != 10 {
+		t.Errorf("Area after Redo: got %g", got)
+	}
+}
+
+func TestFluent(t *testing.T) {
+	var b Builder = &BuilderImpl{}
+	if got := b.WithName("x").WithTags("a", "b").WithTags("c"); got != b {
+		t.Errorf("fluent methods should return the receiver")
+	}
+	if b.Name() != "x" || strings.Join(b.Tags(), " ") != "a b c" {
+		t.Errorf("got %q, %v", b.Name(), b.Tags())
+	}
+}
+
+func TestSwapCAS(t *testing.T) {
+	m := &MachineImpl{}
+	if old := m.SetState(Running); old != Idle {
+		t.Errorf("SetState: got previous state %v", old)
+	}
+	if m.CompareAndSetState(Idle, Stopped) || m.State() != Running {
+		t.Errorf("CompareAndSetState succeeded with the wrong old state")
+	}
+	if !m.CompareAndSetState(Running, Stopped) || m.State() != Stopped {
+		t.Errorf("CompareAndSetState failed")
+	}
+	a, b := "a", "b"
+	if old := m.SwapCurrent(&a); old != nil {
+		t.Errorf("SwapCurrent: got %v", old)
+	}
+	if m.CasCurrent(&b, &b) || !m.CasCurrent(&a, &b) || m.Current() != &b {
+		t.Errorf("CasCurrent: got %v", m.Current())
+	}
+	// Increment the count from several goroutines with CasCount.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				for {
+					n := m.Count()
+					if m.CasCount(n, n + 1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if got := m.SwapCount(0); got != 1000 {
+		t.Errorf("Count: got %d", got)
+	}
+}
+
+func TestSwapCASJournal(t *testing.T) {
+	j := &runtime.Journal{}
+	m := &MachineImpl{}
+	m.SetJournaler(j)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				for {
+					n := m.Count()
+					if m.CasCount(n, n + 1) {
+						break
+					}
+				}
+				m.DirtySlots()
+			}
+		}()
+	}
+	wg.Wait()
+	if got := m.Count(); got != 1000 {
+		t.Errorf("Count: got %d", got)
+	}
+	// Each successful CasCount recorded the value that it replaced.
+	j.Undo()
+	if got := m.Count(); got != 999 {
+		t.Errorf("Count after Undo: got %d", got)
+	}
+	m.ClearDirty()
+	m.SwapCount(5)
+	m.CompareAndSetState(Idle, Running)
+	if got := fmt.Sprint(m.DirtySlots()); got != "[state count]" {
+		t.Errorf("DirtySlots: got %s", got)
+	}
+	j.Undo()
+	j.Undo()
+	if m.Count() != 999 || m.State() != Idle {
+		t.Errorf("after Undo: got %d, %v", m.Count(), m.State())
+	}
+}
+
+func TestEqualHash(t *testing.T) {
+	point := func(x int, next Point) Point {
+		p := &PointImpl{}
+		p.SetX(x)
+		p.SetTags([]string{ "a", "b" })
+		p.SetAttrs(map[string][]int{ "k": { 1, 2 } })
+		p.SetNext(next)
+		return p
+	}
+	p1, p2 := point(1, point(2, nil)), point(1, point(2, nil))
+	if !p1.Equal(p2) || p1.Hash() != p2.Hash() {
+		t.Errorf("equal points should be Equal and have the same Hash")
+	}
+	p2.Next().SetX(3)
+	if p1.Equal(p2) {
+		t.Errorf("Next should be compared by value")
+	}
+	p2.Next().SetX(2)
+	p2.SetTags([]string{ "a" })
+	if p1.Equal(p2) {
+		t.Errorf("Tags should be compared")
+	}
+	p2.SetTags([]string{ "a", "b" })
+	p2.SetAttrs(map[string][]int{ "k": { 1, 3 } })
+	if p1.Equal(p2) {
+		t.Errorf("Attrs should be compared")
+	}
+	if p1.Equal(nil) {
+		t.Errorf("a Point shouldn't equal nil")
+	}
+	n1, n2 := &NamedImpl{}, &NamedImpl{}
+	n1.SetName("n")
+	n2.SetName("n")
+	n1.SetNote("one")
+	n2.SetNote("two")
+	if !n1.Equal(n2) || n1.Hash() != n2.Hash() {
+		t.Errorf("only the name should be compared")
+	}
+	// n1's kind has its default value, which n2's is set to.
+	n2.SetKind("thing")
+	if !n1.Equal(n2) || !n2.Equal(n1) || n1.Hash() != n2.Hash() {
+		t.Errorf("the default kind should be compared")
+	}
+	n2.SetKind("other")
+	if n1.Equal(n2) || n2.Equal(n1) {
+		t.Errorf("the kinds differ")
+	}
+}
+
+func TestEqualHashConcurrent(t *testing.T) {
+	n1, n2 := &NamedImpl{}, &NamedImpl{}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				n1.SetName(fmt.Sprint(j))
+				n2.SetKind(fmt.Sprint(j))
+				n1.Equal(n2)
+				n2.Equal(n1)
+				n1.Hash()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCompare(t *testing.T) {
+	job := func(priority int, name string) Job {
+		j := &JobImpl{}
+		j.SetPriority(priority)
+		j.SetName(name)
+		return j
+	}
+	jobs := []Job{ job(1, "b"), job(2, "z"), job(1, "a"), job(3, "m") }
+	SortJobs(jobs)
+	got := []string{}
+	for _, j := range jobs {
+		got = append(got, j.Name())
+	}
+	if strings.Join(got, " ") != "m z a b" {
+		t.Errorf("SortJobs: got %v", got)
+	}
+	if c := job(1, "a").Compare(job(1, "a")); c != 0 {
+		t.Errorf("Compare: got %d for equal keys", c)
+	}
+	// A nil version orders before any other.
+	versioned := func(name string, v *Version) Job {
+		j := job(1, name)
+		j.SetVersion(v)
+		return j
+	}
+	jobs = []Job{
+		versioned("a", &Version{ 2, 0 }),
+		versioned("b", &Version{ 1, 5 }),
+		versioned("c", nil),
+		versioned("d", &Version{ 1, 5 }),
+	}
+	SortJobs(jobs)
+	got = []string{}
+	for _, j := range jobs {
+		got = append(got, j.Name())
+	}
+	if strings.Join(got, " ") != "c b d a" {
+		t.Errorf("SortJobs by version: got %v", got)
+	}
+}
+
+// evaluator is an ExprVisitor that computes the value of an Expr.
+type evaluator struct {
+	value int
+}
+
+func (e *evaluator) VisitLiteral(l Literal) {
+	e.value = l.Value()
+}
+
+func (e *evaluator) VisitSum(s Sum) {
+	total := 0
+	for _, op := range s.Operands() {
+		op.Accept(e)
+		total += e.value
+	}
+	e.value = total
+}
+
+// literalCounter only cares about Literals.
+type literalCounter struct {
+	BaseExprVisitor
+	count int
+}
+
+func (c *literalCounter) VisitLiteral(Literal) {
+	c.count += 1
+}
+
+func TestVisitor(t *testing.T) {
+	lit := func(v int) Expr {
+		l := &LiteralImpl{}
+		l.SetValue(v)
+		return l
+	}
+	inner := &SumImpl{}
+	inner.AddOperands(lit(2), lit(3))
+	outer := &SumImpl{}
+	outer.AddOperands(lit(1), inner)
+	e := &evaluator{}
+	outer.Accept(e)
+	if e.value != 6 {
+		t.Errorf("Expected 6, got %d", e.value)
+	}
+	c := &literalCounter{}
+	for _, op := range outer.Operands() {
+		op.Accept(c)
+	}
+	if c.count != 1 {
+		t.Errorf("Expected 1 Literal, got %d", c.count)
+	}
+}
+
+func TestStack(t *testing.T) {
+	p := Pile(&PileImpl{})
+	if _, ok := p.Pop(); ok {
+		t.Errorf("Pop of empty Pile succeeded")
+	}
+	p.Push("a", "b")
+	p.Push("c")
+	if top, ok := p.Top(); !ok || top != "c" {
+		t.Errorf("Top: got %q, %v", top, ok)
+	}
+	for _, want := range []string{ "c", "b" } {
+		if got, ok := p.Pop(); !ok || got != want {
+			t.Errorf("Pop: want %q, got %q, %v", want, got, ok)
+		}
+	}
+	if got := p.MustPop(); got != "a" {
+		t.Errorf("MustPop: want %q, got %q", "a", got)
+	}
+	if got := p.Height(); got != 0 {
+		t.Errorf("Height: want 0, got %d", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustPop of empty Pile didn't panic")
+		}
+	}()
+	p.MustPop()
+}
+
+func TestQueue(t *testing.T) {
+	j := &runtime.Journal{}
+	b := &BacklogImpl{}
+	b.SetJournaler(j)
+	// Enough to make the ring buffer wrap around and grow.
+	for i := 0; i < 3; i++ {
+		b.Enqueue(i)
+	}
+	b.Dequeue()
+	for i := 3; i < 10; i++ {
+		b.Enqueue(i)
+	}
+	if got := b.Pending(); got != 9 {
+		t.Errorf("Pending: want 9, got %d", got)
+	}
+	if next, ok := b.Next(); !ok || next != 1 {
+		t.Errorf("Next: got %d, %v", next, ok)
+	}
+	for want := 1; want < 10; want++ {
+		if got, ok := b.Dequeue(); !ok || got != want {
+			t.Errorf("Dequeue: want %d, got %d, %v", want, got, ok)
+		}
+	}
+	if _, ok := b.Dequeue(); ok {
+		t.Errorf("Dequeue of empty Backlog succeeded")
+	}
+	j.Undo()
+	if next, ok := b.Next(); !ok || next != 9 {
+		t.Errorf("Next after Undo: got %d, %v", next, ok)
+	}
+	for j.Undo() {
+	}
+	if got := b.Pending(); got != 0 {
+		t.Errorf("Pending after undoing everything: want 0, got %d", got)
+	}
+	// Three enqueues, a dequeue and seven more enqueues.
+	for i := 0; i < 11; i++ {
+		j.Redo()
+	}
+	if next, ok := b.Next(); !ok || next != 1 || b.Pending() != 9 {
+		t.Errorf("After redoing the enqueues: got %d, %v, %d", next, ok, b.Pending())
+	}
+}
@@ -5,6 +5,7 @@ import "reflect"
 import "strings"
 import "go/ast"
 import "go/token"
+import "go/types"
 
 
 var AST_MATCH_DEBUG_DUMP = false
@@ -15,7 +16,8 @@ var AST_MATCH_DEBUG_DUMP_ALL = false
 //
 // If the name of an ast.Ident in pattern starts with '_', then that
 // name and the corresponding value from candidate are added to
-// scratchpad.
+// scratchpad.  If such a name appears more than once in pattern then
+// the corresponding values from candidate must be the same expression.
 //
 // If IGNORE appears as an identifier in pattern then it matches the
 // corresponding element of candidate.
@@ -63,8 +65,16 @@ func AstMatch(pattern interface{}, candidate interface{}, scratchpad map[string]
 				return true, nil
 			}
 			if strings.HasPrefix(p.Name, "_") {
-				_, ok := scratchpad[p.Name]
+				previous, ok := scratchpad[p.Name]
 				if ok {
+					// A variable that appears more than
+					// once must match the same expression
+					// each time.
+					pe, ok1 := previous.(ast.Expr)
+					ce, ok2 := candidate.(ast.Expr)
+					if ok1 && ok2 && types.ExprString(pe) == types.ExprString(ce) {
+						return true, nil
+					}
 					return false, fmt.Errorf("%s%s already set",
 						err_prefix, p.Name)
 				} else {
@@ -87,6 +97,19 @@ func AstMatch(pattern interface{}, candidate interface{}, scratchpad map[string]
 					err_prefix, pattern, candidate)
 			}
 			return astm(p.Type, candidate.(*ast.Field).Type, stack)
+		case *ast.ChanType:
+			c, ok := candidate.(*ast.ChanType)
+			if !ok {
+				return false, fmt.Errorf("%sExpected candidate to be ast.ChanType, not %T",
+					err_prefix, candidate)
+			}
+			// Dir isn't a pointer, so the kludge below can't
+			// compare it.
+			if p.Dir != c.Dir {
+				return false, fmt.Errorf("%sChannel directions don't match: %v, %v",
+					err_prefix, p.Dir, c.Dir)
+			}
+			return astm(p.Value, c.Value, stack)
 		case *ast.FieldList:
 			if ok, name := wholeFieldList(p); ok {
 				scratchpad[name] = candidate
@@ -101,12 +124,21 @@ func AstMatch(pattern interface{}, candidate interface{}, scratchpad map[string]
 							err_prefix)
 					}
 				}
-				if len(p.List) != len(c.List) {
+				if p == nil {
+					if len(c.List) == 0 {
+						return true, nil
+					}
+					return false, fmt.Errorf("%spattern is nil against non-empty FieldList candidate",
+						err_prefix)
+				}
+				// (a, b int) should match (a int, b int).
+				pl, cl := expandFields(p), expandFields(c)
+				if len(pl) != len(cl) {
 					return false, fmt.Errorf("%sFieldLists differ in length",
 						err_prefix)
 				}
-				for i := 0; i < len(p.List); i++ {
-					if ok, err := astm (p.List[i], c.List[i], stack); !ok {
+				for i := 0; i < len(pl); i++ {
+					if ok, err := astm (pl[i], cl[i], stack); !ok {
 						return false, err
 					}
 				}
@@ -182,6 +214,19 @@ func AstMatch(pattern interface{}, candidate interface{}, scratchpad map[string]
 	return astm(pattern, candidate, (*stackLevel)(nil))
 }
 
+// expandFields returns the Fields of l, with a Field that declares
+// several names repeated once for each of them.
+func expandFields(l *ast.FieldList) []*ast.Field {
+	fields := []*ast.Field{}
+	for _, f := range FieldListSlice(l) {
+		fields = append(fields, f)
+		for i := 1; i < len(f.Names); i++ {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
 func wholeFieldList(f *ast.FieldList) (bool, string) {
 	s := FieldListSlice(f)
 	if len(s) != 1 {
@@ -45,3 +45,91 @@ func TestASTMatch(t *testing.T) {
 	}
 }
 
+
+const generic_pattern = `
+package foo
+
+import "iter"
+
+type myInterface interface {
+	Things() iter.Seq2[string, int]
+}
+
+func (x *_STRUCT_NAME) Things() iter.Seq2[_KEY_TYPE, _SLOT_TYPE] {
+	return nil
+}
+`  // End
+
+func TestASTMatchGeneric(t *testing.T) {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "pattern", generic_pattern, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("Error while parsing pattern: %s", err)
+		return
+	}
+	my_interface := parsed.Decls[1].(*ast.GenDecl).Specs[0].(*ast.TypeSpec)
+	method := parsed.Decls[2].(*ast.FuncDecl)
+	expectType := method.Type
+	gotType := my_interface.Type.(*ast.InterfaceType).Methods.List[0].Type
+	scratchpad :=  map[string]interface{}{}
+	matched, err := AstMatch(expectType, gotType, scratchpad)
+	if !matched {
+		t.Fatalf("Didn't match: %s", err)
+	}
+	for name, want := range map[string]string{ "_KEY_TYPE": "string", "_SLOT_TYPE": "int" } {
+		if got, ok := scratchpad[name].(*ast.Ident); !ok || got.Name != want {
+			t.Errorf("%s: want %s, got %#v", name, want, scratchpad[name])
+		}
+	}
+}
+
+func TestASTMatchRepeatedVariable(t *testing.T) {
+	match := func(pattern, candidate string) bool {
+		p, err := parser.ParseExpr(pattern)
+		if err != nil {
+			t.Fatalf("%s", err)
+		}
+		c, err := parser.ParseExpr(candidate)
+		if err != nil {
+			t.Fatalf("%s", err)
+		}
+		matched, _ := AstMatch(p, c, map[string]interface{}{})
+		return matched
+	}
+	if !match("func(_SLOT_TYPE) (_SLOT_TYPE, bool)", "func(*Foo) (*Foo, bool)") {
+		t.Errorf("Repeated variable should match the same type")
+	}
+	if match("func(_SLOT_TYPE) (_SLOT_TYPE, bool)", "func(Foo) (Bar, bool)") {
+		t.Errorf("Repeated variable shouldn't match different types")
+	}
+	if !match("func(old _SLOT_TYPE, v _SLOT_TYPE) bool", "func(old, new Foo) bool") {
+		t.Errorf("Parameters that share a type should match separate ones")
+	}
+	if match("func(_SLOT_TYPE)", "func(Foo) Foo") {
+		t.Errorf("A pattern without results shouldn't match a function with them")
+	}
+}
+
+func TestASTMatchChan(t *testing.T) {
+	match := func(pattern, candidate string) bool {
+		p, err := parser.ParseExpr(pattern)
+		if err != nil {
+			t.Fatalf("%s", err)
+		}
+		c, err := parser.ParseExpr(candidate)
+		if err != nil {
+			t.Fatalf("%s", err)
+		}
+		matched, _ := AstMatch(p, c, map[string]interface{}{})
+		return matched
+	}
+	if !match("func() <-chan _SLOT_TYPE", "func() <-chan *Foo") {
+		t.Errorf("Receive only channels should match")
+	}
+	if match("func() <-chan _SLOT_TYPE", "func() chan *Foo") {
+		t.Errorf("Channel directions should have to match")
+	}
+	if match("func(chan<- _SLOT_TYPE)", "func(<-chan Foo)") {
+		t.Errorf("Channel directions should have to match")
+	}
+}
@@ -40,6 +40,22 @@ func ImportSpecMatch(ispec *ast.ImportSpec, name string) (ImportAdder, error) {
 }
 
 
+// StandardImports maps the names of standard library packages that
+// generated code might refer to to their import paths.  Such a package
+// is imported into the output file even if the input file doesn't
+// import it.
+var StandardImports = map[string]string{
+	"atomic": "sync/atomic",
+	"cmp": "cmp",
+	"fmt": "fmt",
+	"json": "encoding/json",
+	"maps": "maps",
+	"slices": "slices",
+	"strings": "strings",
+	"sync": "sync",
+}
+
+
 type visitor struct {
 	fset *token.FileSet
 	in *ast.File
@@ -66,6 +82,7 @@ func (v *visitor) Visit(node ast.Node) ast.Visitor {
 		left := LeftmostSelector(n)
 		if left != nil {
 			// left might be a package reference
+			found := false
 			for _, ispec := range v.in.Imports {
 				f, err := ImportSpecMatch(ispec, left.Name)
 				if err != nil {
@@ -74,9 +91,15 @@ func (v *visitor) Visit(node ast.Node) ast.Visitor {
 				}
 				if f != nil {
 					f(v.fset, v.out)
+					found = true
 					break
 				}
 			}
+			// A package name won't have been resolved to
+			// an object by the parser.
+			if path, ok := StandardImports[left.Name]; ok && !found && left.Obj == nil {
+				astutil.AddImport(v.fset, v.out, path)
+			}
 		}
 	}
 	return v
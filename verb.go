@@ -109,6 +109,9 @@ func GlobalDefinitions(vp VerbPhrase) (string, error) {
 type VerbDefinition interface {
 	Tag() string
 	Description() string
+	// Mutating returns true if the methods that the verb
+	// generates might modify the object they are called on.
+	Mutating() bool
 	NewVerbPhrase(*context, *InterfaceDefinition, *ast.Field, *ast.Comment) (VerbPhrase, error)
 	GlobalsTemplate() *template.Template
 	StructBody(VerbPhrase) (string, error)
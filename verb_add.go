@@ -0,0 +1,234 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "text/template"
+
+
+type AddVerbPhrase struct {
+	setVerbPhrase
+	// form identifies which of the templates in
+	// add_method_template matched the method signature.
+	form string
+}
+
+var _ VerbPhrase = (*AddVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*AddVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*AddVerbPhrase)(nil)
+var _ Invertible = (*AddVerbPhrase)(nil)
+
+func (vp *AddVerbPhrase) Form() string {
+	return vp.form
+}
+
+
+// CounterAddVerbPhrase is the VerbPhrase of the add verb when it
+// applies to a numeric slot.
+type CounterAddVerbPhrase struct {
+	counterVerbPhrase
+}
+
+var _ VerbPhrase = (*CounterAddVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*CounterAddVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*CounterAddVerbPhrase)(nil)
+
+// JournalCapture is part of the Invertible interface.  Only the members
+// that weren't already present are recorded in defimpl_added.
+func (vp *AddVerbPhrase) JournalCapture() string {
+	elt := vp.TypeString(vp.ElementType())
+	if vp.Form() == "add_one" {
+		return fmt.Sprintf("defimpl_added := []%s{ v }", elt)
+	}
+	absent := fmt.Sprintf("!x.%s.Contains(m)", vp.SlotName())
+	if !vp.Ordered() {
+		absent = fmt.Sprintf("_, ok := x.%s[m]; !ok", vp.SlotName())
+	}
+	return fmt.Sprintf(`defimpl_added := []%s{}
+for _, m := range members {
+	if %s && !slices.Contains(defimpl_added, m) {
+		defimpl_added = append(defimpl_added, m)
+	}
+}`, elt, absent)
+}
+
+// JournalUndo is part of the Invertible interface.
+func (vp *AddVerbPhrase) JournalUndo() string {
+	return fmt.Sprintf("for _, m := range defimpl_added {\n%s\n}", vp.removeMember("m"))
+}
+
+// JournalRedo is part of the Invertible interface.
+func (vp *AddVerbPhrase) JournalRedo() string {
+	return fmt.Sprintf("for _, m := range defimpl_added {\n%s\n}", vp.addMember("m"))
+}
+
+
+
+type Verb_Add struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Add)(nil)
+
+func init() {
+	vd := &Verb_Add{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Add) Tag() string { return "add" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Add) Description() string {
+	return "adds the specified values to the set valued field, or the specified amount to the numeric field."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Add) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Add) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	// A slot that none of the other set verbs concern might be a
+	// counter instead of a set.
+	if !slotHasVerb(idef, slot, "contains", "remove", "members") {
+		cvp, err := newCounterVerbPhrase(ctx, vd, idef, field, comment,
+			add_method_template, "add_number_result", "add_number")
+		if err == nil && cvp.SlotType() != nil {
+			vp := &CounterAddVerbPhrase{
+				counterVerbPhrase: cvp,
+			}
+			if err := addSlotSpec(idef, vp); err != nil {
+				return nil, err
+			}
+			return vp, nil
+		}
+	}
+	// Which form applies is decided by whether the method is
+	// variadic, so that an error about the element type concerns
+	// the element type rather than that of the variadic parameter.
+	form := "add_one"
+	if isVariadic(field) {
+		form = "add_variadic"
+	}
+	svp, err := newSetVerbPhrase(ctx, vd, idef, field, comment,
+		add_method_template.Lookup(form))
+	if err != nil {
+		return nil, err
+	}
+	vp := &AddVerbPhrase{
+		setVerbPhrase: svp,
+		form: form,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var add_method_template = template.Must(
+	template.New("add_method_template").Parse(`
+{{- define "add_member"}}
+	{{- if .Ordered}}
+		x.{{.SlotName}}.Add(v)
+	{{- else}}
+		if x.{{.SlotName}} == nil {
+			x.{{.SlotName}} = {{.TypeString .SlotType}}{}
+		}
+		x.{{.SlotName}}[v] = struct{}{}
+	{{- end}}
+{{- end}}
+
+{{- define "add_absent"}}
+	{{- if .Ordered}}!x.{{.SlotName}}.Contains(v)
+	{{- else}}_, ok := x.{{.SlotName}}[v]; !ok
+	{{- end}}
+{{- end}}
+
+{{- define "add_variadic"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(members ...{{.TypeString .ElementType}}) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	// Adding only members that are already present changes nothing.
+	for _, v := range members {
+		if {{template "add_absent" .}} {
+			{{.BeforeMutation}}
+			for _, v := range members {
+				{{- template "add_member" .}}
+			}
+			{{.AfterMutation}}
+			return
+		}
+	}
+}
+{{end}}
+
+{{- define "add_one"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(v {{.TypeString .ElementType}}) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	if {{template "add_absent" .}} {
+		{{.BeforeMutation}}
+		{{- template "add_member" .}}
+		{{.AfterMutation}}
+	}
+}
+{{end}}
+
+{{- define "add_number"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(delta {{.TypeString .SlotType}}) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	{{.Adjust "+" "delta" ""}}
+	{{.AfterMutation}}
+}
+{{end}}
+
+{{- define "add_number_result"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(delta {{.TypeString .SlotType}}) {{.TypeString .SlotType}} {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	{{.Adjust "+" "delta" "v"}}
+	{{.AfterMutation}}
+	return v
+}
+{{end}}
+
+{{- if eq .Form "add_variadic"}}{{template "add_variadic" .}}{{end}}
+{{- if eq .Form "add_one"}}{{template "add_one" .}}{{end}}
+{{- if eq .Form "add_number"}}{{template "add_number" .}}{{end}}
+{{- if eq .Form "add_number_result"}}{{template "add_number_result" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Add) GlobalsTemplate() *template.Template {
+	return add_method_template
+}
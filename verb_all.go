@@ -0,0 +1,83 @@
+package main
+
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+type AllVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*AllVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*AllVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*AllVerbPhrase)(nil)
+
+
+type Verb_All struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_All)(nil)
+
+func init() {
+	vd := &Verb_All{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_All) Tag() string { return "all" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_All) Description() string {
+	return "returns true if every element of the slice valued field satisfies the specified predicate."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_All) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_All) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	slot_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &AllVerbPhrase{
+		slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+			slot_type: types.NewSlice(slot_type),
+		},
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var all_method_template = template.Must(
+	template.New("all_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(pred func({{.TypeString .SlotType.Elem}}) bool) bool {
+	{{.Elements}}
+	for _, v := range elements {
+		if !pred(v) {
+			return false
+		}
+	}
+	return true
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_All) GlobalsTemplate() *template.Template {
+	return all_method_template
+}
@@ -0,0 +1,68 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type AncestorsVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*AncestorsVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*AncestorsVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*AncestorsVerbPhrase)(nil)
+
+
+type Verb_Ancestors struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Ancestors)(nil)
+
+func init() {
+	vd := &Verb_Ancestors{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Ancestors) Tag() string { return "ancestors" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Ancestors) Description() string {
+	return "returns the parent of the object, its parent, and so on up to the root of the tree, following the parents of the elements of a slot with the children option."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Ancestors) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Ancestors) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newTreeVerbPhrase(ctx, vd, idef, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	vp := &AncestorsVerbPhrase{ svp }
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var ancestors_method_template = template.Must(
+	template.New("ancestors_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() []{{.TypeString .SlotType.Elem}} {
+	var ancestors []{{.TypeString .SlotType.Elem}}
+	var n {{.TypeString .SlotType.Elem}} = x
+	for p := n.{{.Children.Getter}}(); p != nil; p = n.{{.Children.Getter}}() {
+		ancestors = append(ancestors, p)
+		n = p
+	}
+	return ancestors
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Ancestors) GlobalsTemplate() *template.Template {
+	return ancestors_method_template
+}
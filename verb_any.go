@@ -0,0 +1,83 @@
+package main
+
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+type AnyVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*AnyVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*AnyVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*AnyVerbPhrase)(nil)
+
+
+type Verb_Any struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Any)(nil)
+
+func init() {
+	vd := &Verb_Any{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Any) Tag() string { return "any" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Any) Description() string {
+	return "returns true if any element of the slice valued field satisfies the specified predicate."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Any) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Any) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	slot_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &AnyVerbPhrase{
+		slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+			slot_type: types.NewSlice(slot_type),
+		},
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var any_method_template = template.Must(
+	template.New("any_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(pred func({{.TypeString .SlotType.Elem}}) bool) bool {
+	{{.Elements}}
+	for _, v := range elements {
+		if pred(v) {
+			return true
+		}
+	}
+	return false
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Any) GlobalsTemplate() *template.Template {
+	return any_method_template
+}
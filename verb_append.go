@@ -1,17 +1,58 @@
 package main
 
+import "errors"
+import "fmt"
 import "go/ast"
 import "go/types"
 import "text/template"
 
 
 type AppendVerbPhrase struct {
-	slotVerbPhrase
+	sortedVerbPhrase
+	// form identifies which of the verb's templates matched the
+	// method signature.
+	form string
 }
 
 var _ VerbPhrase = (*AppendVerbPhrase)(nil)
 var _ SlotVerbPhrase = (*AppendVerbPhrase)(nil)
 var _ GlobalsTemplateParameter = (*AppendVerbPhrase)(nil)
+var _ Invertible = (*AppendVerbPhrase)(nil)
+
+func (vp *AppendVerbPhrase) Form() string {
+	return vp.form
+}
+
+// JournalCapture is part of the Invertible interface.  The positions
+// at which the items of a sorted slot are inserted are collected in
+// defimpl_at.
+func (vp *AppendVerbPhrase) JournalCapture() string {
+	if vp.Sorted() {
+		return "defimpl_added := slices.Clone(v)\ndefimpl_at := make([]int, 0, len(v))"
+	}
+	return fmt.Sprintf("defimpl_added := slices.Clone(v)\ndefimpl_n := len(x.%s)", vp.SlotName())
+}
+
+// JournalUndo is part of the Invertible interface.
+func (vp *AppendVerbPhrase) JournalUndo() string {
+	slot := "x." + vp.SlotName()
+	if vp.Sorted() {
+		return fmt.Sprintf("for k := len(defimpl_at) - 1; k >= 0; k-- {\n%s = slices.Delete(%s, defimpl_at[k], defimpl_at[k] + 1)\n}",
+			slot, slot)
+	}
+	return fmt.Sprintf("%s = slices.Delete(%s, defimpl_n, len(%s))", slot, slot, slot)
+}
+
+// JournalRedo is part of the Invertible interface.
+func (vp *AppendVerbPhrase) JournalRedo() string {
+	slot := "x." + vp.SlotName()
+	if vp.Sorted() {
+		return fmt.Sprintf("for k, item := range defimpl_added {\n%s = slices.Insert(%s, defimpl_at[k], item)\n}",
+			slot, slot)
+	}
+	return fmt.Sprintf("%s = append(%s, defimpl_added...)", slot, slot)
+}
+
 
 
 type Verb_Append struct {
@@ -33,26 +74,54 @@ func (vd *Verb_Append) Description() string {
 	return "appends the specified values to the field."
 }
 
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Append) Mutating() bool { return true }
+
 // NewVerbPhrase is part of the VerbDefinition interface.
 func (vd *Verb_Append) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
 	slot, err := parse_slot_verb_phrase(ctx, field, comment)
 	if err != nil {
 		return nil, err
 	}
-	slot_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	var slot_type types.Type
+	form := ""
+	// Like set, append can be fluent.
+	for _, f := range []string{ "append", "append_fluent" } {
+		t, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate().Lookup(f))
+		if err == nil {
+			slot_type = t
+			form = f
+			break
+		}
+		if errors.Is(err, errNotOwnInterface) {
+			return nil, err
+		}
+	}
+	if form == "" {
+		return nil, fmt.Errorf("defimpl: %s: Method signature inappropriate for verb %q",
+			ctx.fset.Position(comment.Slash), vd.Tag())
+	}
+	ordering, err := getSlotOrdering(ctx, idef, slot, slot_type)
 	if err != nil {
 		return nil, err
 	}
+	if _, err := getSlotChildren(idef, slot, slot_type); err != nil {
+		return nil, err
+	}
 	vp := &AppendVerbPhrase{
-		slotVerbPhrase {
-			baseVerbPhrase: baseVerbPhrase {
-				verb: vd,
-				idef: idef,
-				field: field,
+		sortedVerbPhrase: sortedVerbPhrase {
+			slotVerbPhrase: slotVerbPhrase {
+				baseVerbPhrase: baseVerbPhrase {
+					verb: vd,
+					idef: idef,
+					field: field,
+				},
+				slot_name: slot,
+				slot_type: types.NewSlice(slot_type),
 			},
-			slot_name: slot,
-			slot_type: types.NewSlice(slot_type),
+			ordering: ordering,
 		},
+		form: form,
 	}
 	if err := addSlotSpec(idef, vp); err != nil {
 		return nil, err
@@ -62,10 +131,69 @@ func (vd *Verb_Append) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, fi
 
 var append_method_template =  template.Must(
 	template.New("append_method_template").Parse(`
+{{- define "append_body"}}
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{- with .Children}}
+	for _, c := range v {
+		if p := c.{{.Getter}}(); p != nil {
+			p.{{.Remove}}(c)
+		}
+	}
+	{{- end}}
+	{{.BeforeMutation}}
+	{{- if .Sorted}}
+	// Insert each item after any elements that it doesn't sort before.
+	for _, item := range v {
+		i, j := 0, len(x.{{.SlotName}})
+		for i < j {
+			h := int(uint(i + j) >> 1)
+			if {{.Less "item" (printf "x.%s[h]" .SlotName)}} {
+				j = h
+			} else {
+				i = h + 1
+			}
+		}
+		x.{{.SlotName}} = append(x.{{.SlotName}}, item)
+		copy(x.{{.SlotName}}[i+1:], x.{{.SlotName}}[i:])
+		x.{{.SlotName}}[i] = item
+		{{- if .Journaled}}
+		defimpl_at = append(defimpl_at, i)
+		{{- end}}
+	}
+	{{- else}}
+	x.{{.SlotName}} = append(x.{{.SlotName}}, v...)
+	{{- end}}
+	{{.AfterMutation}}
+	{{- with .Children}}
+	for _, c := range v {
+		c.{{.Setter}}(x)
+	}
+	{{- end}}
+{{- end}}
+
+{{- define "append"}}
 // {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
 func (x *{{.StructName}}) {{.MethodName}} (v ...{{.TypeString .SlotType.Elem}}) {
-	x.{{.SlotName}} = append(x.{{.SlotName}}, v...)
+	{{- template "append_body" .}}
+}
+{{end}}
+
+{{- define "append_fluent"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}} (v ...{{.TypeString .SlotType.Elem}}) {{.InterfaceName}} {
+	{{- template "append_body" .}}
+	return x
 }
+{{end}}
+
+{{- if eq .Form "append"}}{{template "append" .}}{{end}}
+{{- if eq .Form "append_fluent"}}{{template "append_fluent" .}}{{end}}
 `))
 
 // GlobalsTemplate is part of the VerbDefinition interface.
@@ -0,0 +1,104 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+type CasVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*CasVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*CasVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*CasVerbPhrase)(nil)
+
+
+type Verb_Cas struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Cas)(nil)
+
+func init() {
+	vd := &Verb_Cas{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Cas) Tag() string { return "cas" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Cas) Description() string {
+	return "sets the value of the field to the second value provided if it currently has the first, returning true if it did so."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Cas) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Cas) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	if err := rejectChildren(ctx, vd, idef, slot, comment); err != nil {
+		return nil, err
+	}
+	slot_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	if !types.Comparable(slot_type) {
+		return nil, fmt.Errorf("defimpl: %s: verb %q: %s is not comparable",
+			ctx.fset.Position(comment.Slash), vd.Tag(), slot_type)
+	}
+	vp := &CasVerbPhrase{
+		slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+			slot_type: slot_type,
+		},
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var cas_method_template = template.Must(
+	template.New("cas_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(old {{.TypeString .SlotType}}, v {{.TypeString .SlotType}}) bool {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{- if and .Atomic (not .Locked)}}
+	if !x.{{.SlotName}}.CompareAndSwap(old, v) {
+		return false
+	}
+	{{- else}}
+	if {{.Load "x"}} != old {
+		return false
+	}
+	{{.BeforeMutation}}
+	{{.Store "x" "v"}}
+	{{- end}}
+	{{.AfterMutation}}
+	return true
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Cas) GlobalsTemplate() *template.Template {
+	return cas_method_template
+}
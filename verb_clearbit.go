@@ -0,0 +1,74 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type ClearBitVerbPhrase struct {
+	bitVerbPhrase
+}
+
+var _ VerbPhrase = (*ClearBitVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*ClearBitVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*ClearBitVerbPhrase)(nil)
+
+
+type Verb_ClearBit struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_ClearBit)(nil)
+
+func init() {
+	vd := &Verb_ClearBit{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_ClearBit) Tag() string { return "clearbit" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_ClearBit) Description() string {
+	return "clears the bit, named by the bit option, of the integer valued field."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_ClearBit) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_ClearBit) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	fvp, err := newBitVerbPhrase(ctx, vd, idef, field, comment,
+		clearbit_method_template, clearbit_method_template.Name())
+	if err != nil {
+		return nil, err
+	}
+	vp := &ClearBitVerbPhrase{
+		bitVerbPhrase: fvp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var clearbit_method_template = template.Must(
+	template.New("clearbit_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	x.{{.SlotName}} &^= {{.Bit}}
+	{{.AfterMutation}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_ClearBit) GlobalsTemplate() *template.Template {
+	return clearbit_method_template
+}
@@ -0,0 +1,70 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type CloseVerbPhrase struct {
+	chanVerbPhrase
+}
+
+var _ VerbPhrase = (*CloseVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*CloseVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*CloseVerbPhrase)(nil)
+
+
+type Verb_Close struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Close)(nil)
+
+func init() {
+	vd := &Verb_Close{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Close) Tag() string { return "close" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Close) Description() string {
+	return "closes the channel valued field."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Close) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Close) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	cvp, err := newChanVerbPhrase(ctx, vd, idef, field, comment,
+		close_method_template, "close")
+	if err != nil {
+		return nil, err
+	}
+	vp := &CloseVerbPhrase{
+		chanVerbPhrase: cvp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var close_method_template = template.Must(
+	template.New("close_method_template").Parse(`
+{{- define "close"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {
+	{{.Channel}}
+	close(ch)
+}
+{{end}}
+
+{{- if eq .Form "close"}}{{template "close" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Close) GlobalsTemplate() *template.Template {
+	return close_method_template
+}
@@ -0,0 +1,188 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "reflect"
+import "strings"
+import "text/template"
+
+
+// compareKey is one of the slots named by the keys option of the
+// compare verb.
+type compareKey struct {
+	slot string
+	descending bool
+}
+
+type CompareVerbPhrase struct {
+	baseVerbPhrase
+	keys []compareKey
+	// sort_function is the name of the sort function that the sort
+	// option asks for, or "".
+	sort_function string
+}
+
+var _ VerbPhrase = (*CompareVerbPhrase)(nil)
+
+// SortFunction returns the name of the package level function that
+// sorts a slice of the interface type, or "".
+func (vp *CompareVerbPhrase) SortFunction() string {
+	return vp.sort_function
+}
+
+// hasCompareMethod returns true if t has a method Compare(t) int.
+func hasCompareMethod(t types.Type) bool {
+	sig := lookupMethod(t, "Compare")
+	if sig == nil || sig.Params().Len() != 1 || sig.Results().Len() != 1 {
+		return false
+	}
+	return types.Identical(sig.Params().At(0).Type(), t) &&
+		types.Identical(sig.Results().At(0).Type(), types.Typ[types.Int])
+}
+
+// Comparison returns the statements that return the result of
+// comparing the key slots of x with those of o.  A nil key, which only
+// a key with a Compare method can be, orders before any other, or
+// after it if the key is descending.
+func (vp *CompareVerbPhrase) Comparison() (string, error) {
+	idef := vp.InterfaceDefinition()
+	specs := []*slotSpec{}
+	code := []string{}
+	for _, key := range vp.keys {
+		var spec *slotSpec
+		for _, s := range idef.SlotSpecs() {
+			if s.SlotName() == key.slot {
+				spec = s
+			}
+		}
+		if spec == nil {
+			return "", fmt.Errorf("defimpl: %s: keys option: no slot %q",
+				idef.InterfaceName, key.slot)
+		}
+		if slotHasVerb(idef, key.slot, "computed") {
+			return "", fmt.Errorf("defimpl: %s: keys option: slot %q is computed from the others",
+				idef.InterfaceName, key.slot)
+		}
+		specs = append(specs, spec)
+		a, b := slotVariable("x", key.slot), slotVariable("o", key.slot)
+		if key.descending {
+			a, b = b, a
+		}
+		t := spec.SlotType()
+		if basic, ok := t.Underlying().(*types.Basic); ok && basic.Info() & types.IsOrdered != 0 {
+			code = append(code, fmt.Sprintf("if c := cmp.Compare(%s, %s); c != 0 {\n\treturn c\n}", a, b))
+		} else if !hasCompareMethod(t) {
+			return "", fmt.Errorf("defimpl: %s: keys option: slot %q of type %s isn't ordered and has no Compare(%s) int method",
+				idef.InterfaceName, key.slot, t, t)
+		} else if nilable(t) {
+			code = append(code, fmt.Sprintf(`switch {
+case %s == nil && %s != nil:
+	return -1
+case %s != nil && %s == nil:
+	return 1
+case %s != nil:
+	if c := %s.Compare(%s); c != 0 {
+		return c
+	}
+}`, a, b, a, b, a, a, b))
+		} else {
+			code = append(code, fmt.Sprintf("if c := %s.Compare(%s); c != 0 {\n\treturn c\n}", a, b))
+		}
+	}
+	code = append(code, "return 0")
+	return captureSlots(idef, specs, "x", "o") + "\n" + strings.Join(code, "\n"), nil
+}
+
+
+type Verb_Compare struct {}
+
+var _ VerbDefinition = (*Verb_Compare)(nil)
+
+func init() {
+	vd := &Verb_Compare{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Compare) Tag() string { return "compare" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Compare) Description() string {
+	return "compares the object with the specified one by the slots named by the keys option, returning a negative number, zero or a positive number like cmp.Compare."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Compare) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Compare) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	_, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	pos := ctx.fset.Position(comment.Slash)
+	tag := reflect.StructTag(comment.Text[2:])
+	vp := &CompareVerbPhrase{
+		baseVerbPhrase: baseVerbPhrase {
+			verb: vd,
+			idef: idef,
+			field: field,
+		},
+		sort_function: tag.Get("sort"),
+	}
+	for _, k := range strings.Split(tag.Get("keys"), ",") {
+		split := strings.Fields(k)
+		if len(split) == 0 {
+			continue
+		}
+		key := compareKey{ slot: split[0] }
+		switch {
+		case len(split) == 1:
+		case len(split) == 2 && split[1] == "asc":
+		case len(split) == 2 && split[1] == "desc":
+			key.descending = true
+		default:
+			return nil, fmt.Errorf("defimpl: %s: verb %q: bad key %q, expected a slot name optionally followed by asc or desc",
+				pos, vd.Tag(), k)
+		}
+		vp.keys = append(vp.keys, key)
+	}
+	if len(vp.keys) == 0 {
+		return nil, fmt.Errorf("defimpl: %s: verb %q: no keys option",
+			pos, vd.Tag())
+	}
+	return vp, nil
+}
+
+var compare_method_template = template.Must(
+	template.New("compare_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(other {{.InterfaceName}}) int {
+	o, ok := other.(*{{.StructName}})
+	if !ok {
+		panic(fmt.Sprintf("(*{{.StructName}}).{{.MethodName}}: can't compare with %T", other))
+	}
+	{{.Comparison}}
+}
+{{- with .SortFunction}}
+
+// {{.}} sorts a slice of {{$.InterfaceName}} by their {{$.MethodName}} method.
+// defimpl verb {{$.Verb.Tag}}.
+func {{.}}(s []{{$.InterfaceName}}) {
+	slices.SortFunc(s, func(a, b {{$.InterfaceName}}) int {
+		return a.{{$.MethodName}}(b)
+	})
+}
+{{- end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Compare) GlobalsTemplate() *template.Template {
+	return compare_method_template
+}
+
+// StructBody is part of the VerbDefinition interface.
+func (vd *Verb_Compare) StructBody(VerbPhrase) (string, error) {
+	return "", nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "text/template"
+
+
+type ComputedVerbPhrase struct {
+	slotVerbPhrase
+	// compute is the name of the function that computes the value.
+	compute string
+}
+
+var _ VerbPhrase = (*ComputedVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*ComputedVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*ComputedVerbPhrase)(nil)
+
+// Recompute returns the code that computes the slot's value if the
+// cached value isn't valid.
+func (vp *ComputedVerbPhrase) Recompute() string {
+	flag := "x." + computedFlag(vp.SlotName())
+	return fmt.Sprintf("if !%s {\n\tx.%s = %s(x)\n\t%s = true\n}",
+		flag, vp.SlotName(), vp.compute, flag)
+}
+
+
+type Verb_Computed struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Computed)(nil)
+
+func init() {
+	vd := &Verb_Computed{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Computed) Tag() string { return "computed" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Computed) Description() string {
+	return "returns the value of the field, which caches the result of the function named by the compute option."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Computed) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Computed) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	slot_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	pos := ctx.fset.Position(comment.Slash)
+	// The compute function calls methods of the object, so the
+	// method couldn't hold the mutex while calling it.
+	if idef.HasOption("(THREADSAFE)") {
+		return nil, fmt.Errorf("defimpl: %s: verb %q can't be used with the (THREADSAFE) option",
+			pos, vd.Tag())
+	}
+	compute := slotOption(idef, slot, "compute")
+	if compute == "" {
+		return nil, fmt.Errorf("defimpl: %s: verb %q: slot %q has no compute option",
+			pos, vd.Tag(), slot)
+	}
+	if !lookupFunction(ctx, compute) {
+		return nil, fmt.Errorf("defimpl: %s: verb %q: compute %q for slot %q: no such function",
+			pos, vd.Tag(), compute, slot)
+	}
+	for _, d := range computedDepends(idef, slot) {
+		if len(slotTags(idef, d)) == 0 {
+			return nil, fmt.Errorf("defimpl: %s: verb %q: slot %q depends on %q, which isn't a slot",
+				pos, vd.Tag(), slot, d)
+		}
+	}
+	vp := &ComputedVerbPhrase{
+		slotVerbPhrase: slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+			slot_type: slot_type,
+		},
+		compute: compute,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var computed_method_template = template.Must(
+	template.New("computed_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {{.TypeString .SlotType}} {
+	{{.Recompute}}
+	return x.{{.SlotName}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Computed) GlobalsTemplate() *template.Template {
+	return computed_method_template
+}
@@ -0,0 +1,76 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type ContainsVerbPhrase struct {
+	setVerbPhrase
+}
+
+var _ VerbPhrase = (*ContainsVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*ContainsVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*ContainsVerbPhrase)(nil)
+
+
+type Verb_Contains struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Contains)(nil)
+
+func init() {
+	vd := &Verb_Contains{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Contains) Tag() string { return "contains" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Contains) Description() string {
+	return "returns true if the specified value is a member of the set valued field."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Contains) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Contains) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newSetVerbPhrase(ctx, vd, idef, field, comment, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &ContainsVerbPhrase{
+		setVerbPhrase: svp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var contains_method_template = template.Must(
+	template.New("contains_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(v {{.TypeString .ElementType}}) bool {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{- if .Ordered}}
+	return x.{{.SlotName}}.Contains(v)
+	{{- else}}
+	_, ok := x.{{.SlotName}}[v]
+	return ok
+	{{- end}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Contains) GlobalsTemplate() *template.Template {
+	return contains_method_template
+}
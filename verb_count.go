@@ -0,0 +1,84 @@
+package main
+
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+type CountVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*CountVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*CountVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*CountVerbPhrase)(nil)
+
+
+type Verb_Count struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Count)(nil)
+
+func init() {
+	vd := &Verb_Count{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Count) Tag() string { return "count" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Count) Description() string {
+	return "returns the number of elements of the slice valued field that satisfy the specified predicate."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Count) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Count) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	slot_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &CountVerbPhrase{
+		slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+			slot_type: types.NewSlice(slot_type),
+		},
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var count_method_template = template.Must(
+	template.New("count_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(pred func({{.TypeString .SlotType.Elem}}) bool) int {
+	{{.Elements}}
+	count := 0
+	for _, v := range elements {
+		if pred(v) {
+			count += 1
+		}
+	}
+	return count
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Count) GlobalsTemplate() *template.Template {
+	return count_method_template
+}
@@ -0,0 +1,97 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type DecrementVerbPhrase struct {
+	counterVerbPhrase
+}
+
+var _ VerbPhrase = (*DecrementVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*DecrementVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*DecrementVerbPhrase)(nil)
+
+
+type Verb_Decrement struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Decrement)(nil)
+
+func init() {
+	vd := &Verb_Decrement{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Decrement) Tag() string { return "decrement" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Decrement) Description() string {
+	return "subtracts one from the numeric field, optionally returning the new value."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Decrement) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Decrement) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	cvp, err := newCounterVerbPhrase(ctx, vd, idef, field, comment,
+		decrement_method_template, "decrement_result", "decrement")
+	if err != nil {
+		return nil, err
+	}
+	vp := &DecrementVerbPhrase{
+		counterVerbPhrase: cvp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var decrement_method_template = template.Must(
+	template.New("decrement_method_template").Parse(`
+{{- define "decrement_lock"}}
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+{{- end}}
+
+{{- define "decrement"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {
+	{{- template "decrement_lock" .}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	{{.Adjust "-" "1" ""}}
+	{{.AfterMutation}}
+}
+{{end}}
+
+{{- define "decrement_result"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {{.TypeString .SlotType}} {
+	{{- template "decrement_lock" .}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	{{.Adjust "-" "1" "v"}}
+	{{.AfterMutation}}
+	return v
+}
+{{end}}
+
+{{- if eq .Form "decrement"}}{{template "decrement" .}}{{end}}
+{{- if eq .Form "decrement_result"}}{{template "decrement_result" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Decrement) GlobalsTemplate() *template.Template {
+	return decrement_method_template
+}
@@ -48,6 +48,12 @@ func (vd *Verb_Delegate) Description() string {
 	return "the method will delegate to another object."
 }
 
+// Mutating is part of the VerbDefinition interface.
+//
+// There is no telling what the delegate does, so it is assumed to be
+// mutating.
+func (vd *Verb_Delegate) Mutating() bool { return true }
+
 // NewVerbPhrase is part of the VerbDefinition interface.
 func (vd *Verb_Delegate) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
 	_, err, scratchpad := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
@@ -1,5 +1,6 @@
 package main
 
+import "fmt"
 import "go/ast"
 import "go/types"
 import "text/template"
@@ -12,6 +13,23 @@ type DeleteVerbPhrase struct {
 var _ VerbPhrase = (*DeleteVerbPhrase)(nil)
 var _ SlotVerbPhrase = (*DeleteVerbPhrase)(nil)
 var _ GlobalsTemplateParameter = (*DeleteVerbPhrase)(nil)
+var _ Invertible = (*DeleteVerbPhrase)(nil)
+
+// JournalCapture is part of the Invertible interface.
+func (vp *DeleteVerbPhrase) JournalCapture() string {
+	return ""
+}
+
+// JournalUndo is part of the Invertible interface.
+func (vp *DeleteVerbPhrase) JournalUndo() string {
+	return fmt.Sprintf("x.%s = slices.Insert(x.%s, i, item)", vp.SlotName(), vp.SlotName())
+}
+
+// JournalRedo is part of the Invertible interface.
+func (vp *DeleteVerbPhrase) JournalRedo() string {
+	return fmt.Sprintf("x.%s = slices.Delete(x.%s, i, i + 1)", vp.SlotName(), vp.SlotName())
+}
+
 
 
 type Verb_Delete struct {
@@ -33,6 +51,9 @@ func (vd *Verb_Delete) Description() string {
 	return "deletes the specified item from the filed."
 }
 
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Delete) Mutating() bool { return true }
+
 // NewVerbPhrase is part of the VerbDefinition interface.
 func (vd *Verb_Delete) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
 	slot, err := parse_slot_verb_phrase(ctx, field, comment)
@@ -64,6 +85,13 @@ var delete_method_template = template.Must(
 	template.New("delete_method_template").Parse(`
 // {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
 func (x *{{.StructName}}) {{.MethodName}} (item {{.TypeString .SlotType.Elem}}) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
 	i := -1
 	for j, v := range x.{{.SlotName}} {
 		if v == item {
@@ -72,7 +100,12 @@ func (x *{{.StructName}}) {{.MethodName}} (item {{.TypeString .SlotType.Elem}})
 		}
 	}
 	if i >= 0 {
+		{{.BeforeMutation}}
 		x.{{.SlotName}} = append(x.{{.SlotName}}[:i], x.{{.SlotName}}[i+1:]...)
+		{{.AfterMutation}}
+		{{- with .Children}}
+		item.{{.Setter}}(nil)
+		{{- end}}
 	}
 }
 `))
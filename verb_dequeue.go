@@ -0,0 +1,124 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "text/template"
+
+
+type DequeueVerbPhrase struct {
+	sequenceVerbPhrase
+}
+
+var _ VerbPhrase = (*DequeueVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*DequeueVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*DequeueVerbPhrase)(nil)
+var _ Invertible = (*DequeueVerbPhrase)(nil)
+
+// JournalCapture is part of the Invertible interface.
+func (vp *DequeueVerbPhrase) JournalCapture() string {
+	return ""
+}
+
+// JournalUndo is part of the Invertible interface.
+func (vp *DequeueVerbPhrase) JournalUndo() string {
+	return fmt.Sprintf("x.%s.PushFront(v)", vp.SlotName())
+}
+
+// JournalRedo is part of the Invertible interface.
+func (vp *DequeueVerbPhrase) JournalRedo() string {
+	return fmt.Sprintf("x.%s.PopFront()", vp.SlotName())
+}
+
+
+
+type Verb_Dequeue struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Dequeue)(nil)
+
+func init() {
+	vd := &Verb_Dequeue{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Dequeue) Tag() string { return "dequeue" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Dequeue) Description() string {
+	return "removes and returns the front element of the queue valued field."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Dequeue) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Dequeue) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newSequenceVerbPhrase(ctx, vd, idef, field, comment,
+		dequeue_method_template, "dequeue_ok", "dequeue_panic")
+	if err != nil {
+		return nil, err
+	}
+	vp := &DequeueVerbPhrase{
+		sequenceVerbPhrase: svp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var dequeue_method_template = template.Must(
+	template.New("dequeue_method_template").Parse(`
+{{- define "dequeue_get"}}
+	{{.BeforeMutation}}
+	v, _ := x.{{.SlotName}}.PopFront()
+	{{.AfterMutation}}
+{{- end}}
+
+{{- define "dequeue_ok"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() ({{.TypeString .ElementType}}, bool) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	if {{if .Queue}}x.{{.SlotName}}.Len(){{else}}len(x.{{.SlotName}}){{end}} == 0 {
+		var zero {{.TypeString .ElementType}}
+		return zero, false
+	}
+	{{- template "dequeue_get" .}}
+	return v, true
+}
+{{end}}
+
+{{- define "dequeue_panic"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {{.TypeString .ElementType}} {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	if {{if .Queue}}x.{{.SlotName}}.Len(){{else}}len(x.{{.SlotName}}){{end}} == 0 {
+		panic("(*{{.StructName}}).{{.MethodName}}: {{.SlotName}} is empty")
+	}
+	{{- template "dequeue_get" .}}
+	return v
+}
+{{end}}
+
+{{- if eq .Form "dequeue_ok"}}{{template "dequeue_ok" .}}{{end}}
+{{- if eq .Form "dequeue_panic"}}{{template "dequeue_panic" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Dequeue) GlobalsTemplate() *template.Template {
+	return dequeue_method_template
+}
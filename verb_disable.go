@@ -0,0 +1,74 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type DisableVerbPhrase struct {
+	flagVerbPhrase
+}
+
+var _ VerbPhrase = (*DisableVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*DisableVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*DisableVerbPhrase)(nil)
+
+
+type Verb_Disable struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Disable)(nil)
+
+func init() {
+	vd := &Verb_Disable{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Disable) Tag() string { return "disable" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Disable) Description() string {
+	return "sets the bool valued field to false."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Disable) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Disable) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	fvp, err := newFlagVerbPhrase(ctx, vd, idef, field, comment,
+		disable_method_template, disable_method_template.Name())
+	if err != nil {
+		return nil, err
+	}
+	vp := &DisableVerbPhrase{
+		flagVerbPhrase: fvp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var disable_method_template = template.Must(
+	template.New("disable_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	x.{{.SlotName}} = false
+	{{.AfterMutation}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Disable) GlobalsTemplate() *template.Template {
+	return disable_method_template
+}
@@ -33,6 +33,9 @@ func (vd *Verb_Discriminate) Description() string {
 	return "the empty method that distinguishes implementors of this interface from those that would otherwise have the same method set."
 }
 
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Discriminate) Mutating() bool { return false }
+
 // NewVerbPhrase is part of the VerbDefinition interface.
 func (vd *Verb_Discriminate) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
 	_, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
@@ -42,6 +42,11 @@ func (vd *Verb_Embed) Description() string {
 	return "Specifies a concrete type to embed to implement an interface."
 }
 
+// Mutating is part of the VerbDefinition interface.
+//
+// The embed verb doesn't generate any methods.
+func (vd *Verb_Embed) Mutating() bool { return false }
+
 // NewVerbPhrase is part of the VerbDefinition interface.
 func (vd *Verb_Embed) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
 	// We expect the method signature to have an interface type
@@ -0,0 +1,74 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type EnableVerbPhrase struct {
+	flagVerbPhrase
+}
+
+var _ VerbPhrase = (*EnableVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*EnableVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*EnableVerbPhrase)(nil)
+
+
+type Verb_Enable struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Enable)(nil)
+
+func init() {
+	vd := &Verb_Enable{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Enable) Tag() string { return "enable" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Enable) Description() string {
+	return "sets the bool valued field to true."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Enable) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Enable) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	fvp, err := newFlagVerbPhrase(ctx, vd, idef, field, comment,
+		enable_method_template, enable_method_template.Name())
+	if err != nil {
+		return nil, err
+	}
+	vp := &EnableVerbPhrase{
+		flagVerbPhrase: fvp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var enable_method_template = template.Must(
+	template.New("enable_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	x.{{.SlotName}} = true
+	{{.AfterMutation}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Enable) GlobalsTemplate() *template.Template {
+	return enable_method_template
+}
@@ -0,0 +1,122 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type EnqueueVerbPhrase struct {
+	sequenceVerbPhrase
+}
+
+var _ VerbPhrase = (*EnqueueVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*EnqueueVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*EnqueueVerbPhrase)(nil)
+var _ Invertible = (*EnqueueVerbPhrase)(nil)
+
+// JournalCapture is part of the Invertible interface.
+func (vp *EnqueueVerbPhrase) JournalCapture() string {
+	return vp.journalPushBackCapture()
+}
+
+// JournalUndo is part of the Invertible interface.
+func (vp *EnqueueVerbPhrase) JournalUndo() string {
+	return vp.journalPushBackUndo()
+}
+
+// JournalRedo is part of the Invertible interface.
+func (vp *EnqueueVerbPhrase) JournalRedo() string {
+	return vp.journalPushBackRedo()
+}
+
+
+
+type Verb_Enqueue struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Enqueue)(nil)
+
+func init() {
+	vd := &Verb_Enqueue{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Enqueue) Tag() string { return "enqueue" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Enqueue) Description() string {
+	return "adds the specified values to the back of the queue valued field."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Enqueue) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Enqueue) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newSequenceVerbPhrase(ctx, vd, idef, field, comment,
+		enqueue_method_template, "enqueue_variadic", "enqueue_one")
+	if err != nil {
+		return nil, err
+	}
+	vp := &EnqueueVerbPhrase{
+		sequenceVerbPhrase: svp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var enqueue_method_template = template.Must(
+	template.New("enqueue_method_template").Parse(`
+{{- define "enqueue_element"}}
+	{{- if .Queue}}
+		x.{{.SlotName}}.PushBack(v)
+	{{- else}}
+		x.{{.SlotName}} = append(x.{{.SlotName}}, v)
+	{{- end}}
+{{- end}}
+
+{{- define "enqueue_variadic"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(elements ...{{.TypeString .ElementType}}) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	for _, v := range elements {
+		{{- template "enqueue_element" .}}
+	}
+	{{.AfterMutation}}
+}
+{{end}}
+
+{{- define "enqueue_one"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(v {{.TypeString .ElementType}}) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	{{- template "enqueue_element" .}}
+	{{.AfterMutation}}
+}
+{{end}}
+
+{{- if eq .Form "enqueue_variadic"}}{{template "enqueue_variadic" .}}{{end}}
+{{- if eq .Form "enqueue_one"}}{{template "enqueue_one" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Enqueue) GlobalsTemplate() *template.Template {
+	return enqueue_method_template
+}
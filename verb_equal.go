@@ -0,0 +1,79 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type EqualVerbPhrase struct {
+	baseVerbPhrase
+}
+
+var _ VerbPhrase = (*EqualVerbPhrase)(nil)
+
+// Comparison returns the statements that compare the slots of x with
+// those of o.
+func (vp *EqualVerbPhrase) Comparison() (string, error) {
+	return newValueComparison(vp.InterfaceDefinition()).Comparison()
+}
+
+
+type Verb_Equal struct {}
+
+var _ VerbDefinition = (*Verb_Equal)(nil)
+
+func init() {
+	vd := &Verb_Equal{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Equal) Tag() string { return "equal" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Equal) Description() string {
+	return "returns true if the specified object has the same implementation and its slots are equal to those of this one."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Equal) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Equal) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	_, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &EqualVerbPhrase{
+		baseVerbPhrase: baseVerbPhrase {
+			verb: vd,
+			idef: idef,
+			field: field,
+		},
+	}
+	return vp, nil
+}
+
+var equal_method_template = template.Must(
+	template.New("equal_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(other {{.InterfaceName}}) bool {
+	o, ok := other.(*{{.StructName}})
+	if !ok || o == nil {
+		return false
+	}
+	if o == x {
+		return true
+	}
+	{{.Comparison}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Equal) GlobalsTemplate() *template.Template {
+	return equal_method_template
+}
+
+// StructBody is part of the VerbDefinition interface.
+func (vd *Verb_Equal) StructBody(VerbPhrase) (string, error) {
+	return "", nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+type FilterVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*FilterVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*FilterVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*FilterVerbPhrase)(nil)
+
+
+type Verb_Filter struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Filter)(nil)
+
+func init() {
+	vd := &Verb_Filter{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Filter) Tag() string { return "filter" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Filter) Description() string {
+	return "returns a new slice of the elements of the slice valued field that satisfy the specified predicate."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Filter) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Filter) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	slot_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &FilterVerbPhrase{
+		slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+			slot_type: types.NewSlice(slot_type),
+		},
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var filter_method_template = template.Must(
+	template.New("filter_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(pred func({{.TypeString .SlotType.Elem}}) bool) []{{.TypeString .SlotType.Elem}} {
+	{{.Elements}}
+	result := []{{.TypeString .SlotType.Elem}}{}
+	for _, v := range elements {
+		if pred(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Filter) GlobalsTemplate() *template.Template {
+	return filter_method_template
+}
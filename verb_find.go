@@ -0,0 +1,171 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+type FindVerbPhrase struct {
+	sortedVerbPhrase
+	// form identifies which of the templates in
+	// find_method_template matched the method signature.
+	form string
+}
+
+var _ VerbPhrase = (*FindVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*FindVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*FindVerbPhrase)(nil)
+
+func (vp *FindVerbPhrase) Form() string {
+	return vp.form
+}
+
+
+type Verb_Find struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Find)(nil)
+
+func init() {
+	vd := &Verb_Find{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Find) Tag() string { return "find" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Find) Description() string {
+	return "returns the first element of the slice valued field that satisfies the specified predicate, or uses binary search to find the specified value in the sorted slice valued field, returning its index or the element, and whether it was found."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Find) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Find) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	pos := ctx.fset.Position(comment.Slash)
+	var slot_type types.Type
+	form := ""
+	for _, f := range []string{ "find_predicate", "find_index", "find_element" } {
+		elt, err, _ := CheckSignatures(ctx, vd, idef.Package(), field,
+			find_method_template.Lookup(f))
+		if err == nil {
+			slot_type = elt
+			form = f
+			break
+		}
+	}
+	if form == "" {
+		return nil, fmt.Errorf("defimpl: %s: Method signature inappropriate for verb %q",
+			pos, vd.Tag())
+	}
+	ordering, err := getSlotOrdering(ctx, idef, slot, slot_type)
+	if err != nil {
+		return nil, err
+	}
+	// Only the binary search forms need the elements to be sorted.
+	if ordering == nil && form != "find_predicate" {
+		return nil, fmt.Errorf("defimpl: %s: verb %q requires that slot %q have the order option",
+			pos, vd.Tag(), slot)
+	}
+	vp := &FindVerbPhrase{
+		sortedVerbPhrase: sortedVerbPhrase {
+			slotVerbPhrase: slotVerbPhrase {
+				baseVerbPhrase: baseVerbPhrase {
+					verb: vd,
+					idef: idef,
+					field: field,
+				},
+				slot_name: slot,
+				slot_type: types.NewSlice(slot_type),
+			},
+			ordering: ordering,
+		},
+		form: form,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var find_method_template = template.Must(
+	template.New("find_method_template").Parse(`
+{{- define "find_search"}}
+	// Find the first element that v doesn't sort after.
+	i, j := 0, len(x.{{.SlotName}})
+	for i < j {
+		h := int(uint(i + j) >> 1)
+		if {{.Less (printf "x.%s[h]" .SlotName) "v"}} {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	found := i < len(x.{{.SlotName}}) && !({{.Less "v" (printf "x.%s[i]" .SlotName)}})
+{{- end}}
+
+{{- define "find_predicate"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(pred func({{.TypeString .SlotType.Elem}}) bool) ({{.TypeString .SlotType.Elem}}, bool) {
+	{{.Elements}}
+	for _, v := range elements {
+		if pred(v) {
+			return v, true
+		}
+	}
+	var zero {{.TypeString .SlotType.Elem}}
+	return zero, false
+}
+{{end}}
+
+{{- define "find_index"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(v {{.TypeString .SlotType.Elem}}) (int, bool) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{- template "find_search" .}}
+	return i, found
+}
+{{end}}
+
+{{- define "find_element"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(v {{.TypeString .SlotType.Elem}}) ({{.TypeString .SlotType.Elem}}, bool) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{- template "find_search" .}}
+	if found {
+		return x.{{.SlotName}}[i], true
+	}
+	var zero {{.TypeString .SlotType.Elem}}
+	return zero, false
+}
+{{end}}
+
+{{- if eq .Form "find_predicate"}}{{template "find_predicate" .}}{{end}}
+{{- if eq .Form "find_index"}}{{template "find_index" .}}{{end}}
+{{- if eq .Form "find_element"}}{{template "find_element" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Find) GlobalsTemplate() *template.Template {
+	return find_method_template
+}
@@ -0,0 +1,130 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "strings"
+import "text/template"
+
+
+type FireVerbPhrase struct {
+	slotVerbPhrase
+	parameters string
+	parameter_names string
+}
+
+var _ VerbPhrase = (*FireVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*FireVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*FireVerbPhrase)(nil)
+
+// MethodParameters returns the parameter list of the method, with
+// each parameter named.
+func (vp *FireVerbPhrase) MethodParameters() string {
+	return vp.parameters
+}
+
+// ParameterNames returns the names of the method's parameters, for
+// passing them on to the event handlers.
+func (vp *FireVerbPhrase) ParameterNames() string {
+	return vp.parameter_names
+}
+
+
+type Verb_Fire struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Fire)(nil)
+
+func init() {
+	vd := &Verb_Fire{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Fire) Tag() string { return "fire" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Fire) Description() string {
+	return "calls each of the event handlers that were added to the field by the listen verb with the specified arguments."
+}
+
+// Mutating is part of the VerbDefinition interface.
+//
+// There is no telling what the event handlers do, so it is assumed to
+// be mutating.
+func (vd *Verb_Fire) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Fire) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	if _, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate()); err != nil {
+		return nil, err
+	}
+	// The type of the event handlers is established by the listen
+	// verb.  The compiler will complain if the handlers can't be
+	// called with the method's parameters.
+	if !slotHasVerb(idef, slot, "listen") {
+		return nil, fmt.Errorf("defimpl: %s: verb %q: slot %q has no listen verb",
+			ctx.fset.Position(comment.Slash), vd.Tag(), slot)
+	}
+	vp := &FireVerbPhrase{
+		slotVerbPhrase: slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+		},
+	}
+	sig := ctx.info.TypeOf(field.Type).(*types.Signature)
+	params := []string{}
+	names := []string{}
+	for i := 0; i < sig.Params().Len(); i++ {
+		name := fmt.Sprintf("a%d", i)
+		t := sig.Params().At(i).Type()
+		if sig.Variadic() && i == sig.Params().Len() - 1 {
+			params = append(params, name + " ..." + vp.TypeString(t.(*types.Slice).Elem()))
+			name += "..."
+		} else {
+			params = append(params, name + " " + vp.TypeString(t))
+		}
+		names = append(names, name)
+	}
+	vp.parameters = strings.Join(params, ", ")
+	vp.parameter_names = strings.Join(names, ", ")
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var fire_method_template = template.Must(
+	template.New("fire_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}({{.MethodParameters}}) {
+	{{- if .Locked}}
+	// Don't hold the mutex while calling the handlers, since they
+	// might add or remove handlers.
+	x.defimpl_mutex.Lock()
+	handlers := x.{{.SlotName}}.Handlers()
+	x.defimpl_mutex.Unlock()
+	{{- else}}
+	handlers := x.{{.SlotName}}.Handlers()
+	{{- end}}
+	for _, h := range handlers {
+		if !h.Removed() {
+			h.F({{.ParameterNames}})
+		}
+	}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Fire) GlobalsTemplate() *template.Template {
+	return fire_method_template
+}
@@ -0,0 +1,68 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type HasVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*HasVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*HasVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*HasVerbPhrase)(nil)
+
+
+type Verb_Has struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Has)(nil)
+
+func init() {
+	vd := &Verb_Has{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Has) Tag() string { return "has" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Has) Description() string {
+	return "returns true if the field has been set by the set verb since it was last unset."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Has) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Has) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newPresenceVerbPhrase(ctx, vd, idef, field, comment, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &HasVerbPhrase{
+		slotVerbPhrase: svp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var has_method_template = template.Must(
+	template.New("has_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() bool {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	return {{.Present}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Has) GlobalsTemplate() *template.Template {
+	return has_method_template
+}
@@ -0,0 +1,72 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type HasBitVerbPhrase struct {
+	bitVerbPhrase
+}
+
+var _ VerbPhrase = (*HasBitVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*HasBitVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*HasBitVerbPhrase)(nil)
+
+
+type Verb_HasBit struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_HasBit)(nil)
+
+func init() {
+	vd := &Verb_HasBit{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_HasBit) Tag() string { return "hasbit" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_HasBit) Description() string {
+	return "returns true if the bit, named by the bit option, of the integer valued field is set."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_HasBit) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_HasBit) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	fvp, err := newBitVerbPhrase(ctx, vd, idef, field, comment,
+		hasbit_method_template, hasbit_method_template.Name())
+	if err != nil {
+		return nil, err
+	}
+	vp := &HasBitVerbPhrase{
+		bitVerbPhrase: fvp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var hasbit_method_template = template.Must(
+	template.New("hasbit_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() bool {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	return x.{{.SlotName}} & {{.Bit}} == {{.Bit}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_HasBit) GlobalsTemplate() *template.Template {
+	return hasbit_method_template
+}
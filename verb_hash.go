@@ -0,0 +1,73 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type HashVerbPhrase struct {
+	baseVerbPhrase
+}
+
+var _ VerbPhrase = (*HashVerbPhrase)(nil)
+
+// Hashing returns the statements that hash the slots of x.
+func (vp *HashVerbPhrase) Hashing() (string, error) {
+	return newValueComparison(vp.InterfaceDefinition()).Hashing()
+}
+
+
+type Verb_Hash struct {}
+
+var _ VerbDefinition = (*Verb_Hash)(nil)
+
+func init() {
+	vd := &Verb_Hash{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Hash) Tag() string { return "hash" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Hash) Description() string {
+	return "returns a hash of the slots of the object that is consistent with the equal verb."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Hash) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Hash) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	_, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &HashVerbPhrase{
+		baseVerbPhrase: baseVerbPhrase {
+			verb: vd,
+			idef: idef,
+			field: field,
+		},
+	}
+	return vp, nil
+}
+
+var hash_method_template = template.Must(
+	template.New("hash_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() uint64 {
+	h := runtime.NewHash()
+	{{.Hashing}}
+	return h.Sum64()
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Hash) GlobalsTemplate() *template.Template {
+	return hash_method_template
+}
+
+// StructBody is part of the VerbDefinition interface.
+func (vd *Verb_Hash) StructBody(VerbPhrase) (string, error) {
+	return "", nil
+}
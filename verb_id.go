@@ -0,0 +1,87 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type IDVerbPhrase struct {
+	baseVerbPhrase
+}
+
+var _ VerbPhrase = (*IDVerbPhrase)(nil)
+
+
+type Verb_ID struct {}
+
+var _ VerbDefinition = (*Verb_ID)(nil)
+
+func init() {
+	vd := &Verb_ID{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_ID) Tag() string { return "id" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_ID) Description() string {
+	return "returns an identifier for the object that is unique within the process.  It is assigned from a counter in defimpl/runtime when first asked for.  While the defimpl/runtime registry is enabled, the method registers the object so that runtime.LookupID can find it."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_ID) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_ID) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	_, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &IDVerbPhrase{
+		baseVerbPhrase: baseVerbPhrase {
+			verb: vd,
+			idef: idef,
+			field: field,
+		},
+	}
+	return vp, nil
+}
+
+// The identifier is assigned with CompareAndSwap so that concurrent
+// first calls agree on it.
+var id_method_template = template.Must(
+	template.New("id_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() uint64 {
+	id := x.defimpl_id.Load()
+	if id == 0 {
+		id = runtime.NewID()
+		if !x.defimpl_id.CompareAndSwap(0, id) {
+			id = x.defimpl_id.Load()
+		}
+	}
+	// The registry might have been enabled since the object was
+	// assigned its identifier.
+	runtime.RegisterObject(id, x)
+	return id
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_ID) GlobalsTemplate() *template.Template {
+	return id_method_template
+}
+
+// StructBody is part of the VerbDefinition interface.  Only the first
+// id method of an interface declares the field for the identifier.
+func (vd *Verb_ID) StructBody(vp VerbPhrase) (string, error) {
+	for _, vp1 := range vp.InterfaceDefinition().VerbPhrases {
+		if vp1.Verb() == vd {
+			if vp1 == vp {
+				return "\tdefimpl_id atomic.Uint64\n", nil
+			}
+			break
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type IncrementVerbPhrase struct {
+	counterVerbPhrase
+}
+
+var _ VerbPhrase = (*IncrementVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*IncrementVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*IncrementVerbPhrase)(nil)
+
+
+type Verb_Increment struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Increment)(nil)
+
+func init() {
+	vd := &Verb_Increment{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Increment) Tag() string { return "increment" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Increment) Description() string {
+	return "adds one to the numeric field, optionally returning the new value."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Increment) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Increment) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	cvp, err := newCounterVerbPhrase(ctx, vd, idef, field, comment,
+		increment_method_template, "increment_result", "increment")
+	if err != nil {
+		return nil, err
+	}
+	vp := &IncrementVerbPhrase{
+		counterVerbPhrase: cvp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var increment_method_template = template.Must(
+	template.New("increment_method_template").Parse(`
+{{- define "increment_lock"}}
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+{{- end}}
+
+{{- define "increment"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {
+	{{- template "increment_lock" .}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	{{.Adjust "+" "1" ""}}
+	{{.AfterMutation}}
+}
+{{end}}
+
+{{- define "increment_result"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {{.TypeString .SlotType}} {
+	{{- template "increment_lock" .}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	{{.Adjust "+" "1" "v"}}
+	{{.AfterMutation}}
+	return v
+}
+{{end}}
+
+{{- if eq .Form "increment"}}{{template "increment" .}}{{end}}
+{{- if eq .Form "increment_result"}}{{template "increment_result" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Increment) GlobalsTemplate() *template.Template {
+	return increment_method_template
+}
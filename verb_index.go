@@ -33,6 +33,9 @@ func (vd *Verb_Index) Description() string {
 	return "returns the element of the specified slice valued field at the specified (zero based) index."
 }
 
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Index) Mutating() bool { return false }
+
 // NewVerbPhrase is part of the VerbDefinition interface.
 func (vd *Verb_Index) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
 	slot, err := parse_slot_verb_phrase(ctx, field, comment)
@@ -64,6 +67,13 @@ var index_method_template = template.Must(
 	template.New("index_method_template").Parse(`
 // {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
 func (x *{{.StructName}}) {{.MethodName}} (index int) {{.TypeString .SlotType.Elem}} {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
 	return x.{{.SlotName}}[index]
 }
 `))
@@ -0,0 +1,132 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+type InsertVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*InsertVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*InsertVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*InsertVerbPhrase)(nil)
+var _ Invertible = (*InsertVerbPhrase)(nil)
+
+// JournalCapture is part of the Invertible interface.
+func (vp *InsertVerbPhrase) JournalCapture() string {
+	return ""
+}
+
+// JournalUndo is part of the Invertible interface.
+func (vp *InsertVerbPhrase) JournalUndo() string {
+	return fmt.Sprintf("x.%s = slices.Delete(x.%s, index, index + 1)", vp.SlotName(), vp.SlotName())
+}
+
+// JournalRedo is part of the Invertible interface.
+func (vp *InsertVerbPhrase) JournalRedo() string {
+	return fmt.Sprintf("x.%s = slices.Insert(x.%s, index, v)", vp.SlotName(), vp.SlotName())
+}
+
+
+
+type Verb_Insert struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Insert)(nil)
+
+func init() {
+	vd := &Verb_Insert{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Insert) Tag() string { return "insert" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Insert) Description() string {
+	return "inserts the specified value into the slice valued field at the specified (zero based) index, which can be the length of the slice."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Insert) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Insert) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	// Placing an element at an arbitrary index would defeat the
+	// order option.
+	if slotOption(idef, slot, "order") != "" {
+		return nil, fmt.Errorf("defimpl: %s: verb %q can't be used with sorted slot %q",
+			ctx.fset.Position(comment.Slash), vd.Tag(), slot)
+	}
+	slot_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := getSlotChildren(idef, slot, slot_type); err != nil {
+		return nil, err
+	}
+	vp := &InsertVerbPhrase{
+		slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+			slot_type: types.NewSlice(slot_type),
+		},
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var insert_method_template = template.Must(
+	template.New("insert_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}} (index int, v {{.TypeString .SlotType.Elem}}) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	if index < 0 || index > len(x.{{.SlotName}}) {
+		panic(fmt.Sprintf("(*{{.StructName}}).{{.MethodName}}: index %d out of range for {{.SlotName}} of length %d",
+			index, len(x.{{.SlotName}})))
+	}
+	{{- with .Children}}
+	// v might already be in the slot, ahead of where it's going.
+	if j := slices.Index(x.{{$.SlotName}}, v); j >= 0 && j < index {
+		index--
+	}
+	if p := v.{{.Getter}}(); p != nil {
+		p.{{.Remove}}(v)
+	}
+	{{- end}}
+	{{.BeforeMutation}}
+	var zero {{.TypeString .SlotType.Elem}}
+	x.{{.SlotName}} = append(x.{{.SlotName}}, zero)
+	copy(x.{{.SlotName}}[index+1:], x.{{.SlotName}}[index:])
+	x.{{.SlotName}}[index] = v
+	{{.AfterMutation}}
+	{{- with .Children}}
+	v.{{.Setter}}(x)
+	{{- end}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Insert) GlobalsTemplate() *template.Template {
+	return insert_method_template
+}
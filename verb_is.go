@@ -0,0 +1,72 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type IsVerbPhrase struct {
+	flagVerbPhrase
+}
+
+var _ VerbPhrase = (*IsVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*IsVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*IsVerbPhrase)(nil)
+
+
+type Verb_Is struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Is)(nil)
+
+func init() {
+	vd := &Verb_Is{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Is) Tag() string { return "is" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Is) Description() string {
+	return "returns the value of the bool valued field."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Is) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Is) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	fvp, err := newFlagVerbPhrase(ctx, vd, idef, field, comment,
+		is_method_template, is_method_template.Name())
+	if err != nil {
+		return nil, err
+	}
+	vp := &IsVerbPhrase{
+		flagVerbPhrase: fvp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var is_method_template = template.Must(
+	template.New("is_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() bool {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	return x.{{.SlotName}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Is) GlobalsTemplate() *template.Template {
+	return is_method_template
+}
@@ -0,0 +1,68 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type IsSetVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*IsSetVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*IsSetVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*IsSetVerbPhrase)(nil)
+
+
+type Verb_IsSet struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_IsSet)(nil)
+
+func init() {
+	vd := &Verb_IsSet{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_IsSet) Tag() string { return "isset" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_IsSet) Description() string {
+	return "is the same as has."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_IsSet) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_IsSet) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newPresenceVerbPhrase(ctx, vd, idef, field, comment, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &IsSetVerbPhrase{
+		slotVerbPhrase: svp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var isset_method_template = template.Must(
+	template.New("isset_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() bool {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	return {{.Present}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_IsSet) GlobalsTemplate() *template.Template {
+	return isset_method_template
+}
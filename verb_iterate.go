@@ -33,6 +33,9 @@ func (vd *Verb_Iterate) Description() string {
 	return "applies the specified function to each element of the slice-valued slot until the function returns false."
 }
 
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Iterate) Mutating() bool { return false }
+
 // NewVerbPhrase is part of the VerbDefinition interface.
 func (vd *Verb_Iterate) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
 	slot, err := parse_slot_verb_phrase(ctx, field, comment)
@@ -64,7 +67,8 @@ var iterate_method_template = template.Must(
 	template.New("iterate_method_template").Parse(`
 // {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
 func (x *{{.StructName}}) {{.MethodName}} (f func(item {{.TypeString .SlotType.Elem}}) bool) {
-	for _, v := range x.{{.SlotName}} {
+	{{.Elements}}
+	for _, v := range elements {
 		if !f(v) {
 			break
 		}
@@ -12,6 +12,18 @@ var _ VerbPhrase = (*LengthVerbPhrase)(nil)
 var _ SlotVerbPhrase = (*LengthVerbPhrase)(nil)
 var _ GlobalsTemplateParameter = (*LengthVerbPhrase)(nil)
 
+// Queue returns true if the slot is a runtime.Queue rather than a
+// slice or map.
+func (vp *LengthVerbPhrase) Queue() bool {
+	return slotHasVerb(vp.InterfaceDefinition(), vp.SlotName(), queueVerbs...)
+}
+
+// OrderedSet returns true if the slot is a runtime.OrderedSet rather
+// than a slice or map.
+func (vp *LengthVerbPhrase) OrderedSet() bool {
+	return setSlotIsOrdered(vp.InterfaceDefinition(), vp.SlotName())
+}
+
 
 type Verb_Length struct {
 	slotVerbDefinition
@@ -29,9 +41,12 @@ func (vd *Verb_Length) Tag() string { return "length" }
 
 // Description is part of the VerbDefinition interface.
 func (vd *Verb_Length) Description() string {
-	return "returns the length of the specified slice valued field."
+	return "returns the length of the specified slice, map, set or queue valued field."
 }
 
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Length) Mutating() bool { return false }
+
 // NewVerbPhrase is part of the VerbDefinition interface.
 func (vd *Verb_Length) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
 	slot, err := parse_slot_verb_phrase(ctx, field, comment)
@@ -62,7 +77,18 @@ var length_method_template = template.Must(
 	template.New("length_method_template").Parse(`
 // {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
 func (x *{{.StructName}}) {{.MethodName}}() int {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{- if or .Queue .OrderedSet}}
+	return x.{{.SlotName}}.Len()
+	{{- else}}
 	return len(x.{{.SlotName}})
+	{{- end}}
 }
 `))
 
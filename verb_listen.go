@@ -0,0 +1,103 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+type ListenVerbPhrase struct {
+	slotVerbPhrase
+	handler_type types.Type
+}
+
+var _ VerbPhrase = (*ListenVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*ListenVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*ListenVerbPhrase)(nil)
+
+// ElementType returns the function type of the slot's event handlers.
+func (vp *ListenVerbPhrase) ElementType() types.Type {
+	return vp.handler_type
+}
+
+
+type Verb_Listen struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Listen)(nil)
+
+func init() {
+	vd := &Verb_Listen{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Listen) Tag() string { return "listen" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Listen) Description() string {
+	return "adds the specified event handler to the field, returning a function that removes it."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Listen) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Listen) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	handler_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := handler_type.Underlying().(*types.Signature); !ok {
+		return nil, fmt.Errorf("defimpl: %s: verb %q: %s is not a function type",
+			ctx.fset.Position(comment.Slash), vd.Tag(), handler_type)
+	}
+	vp := &ListenVerbPhrase{
+		slotVerbPhrase: slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+			slot_type: listenersOf(handler_type),
+		},
+		handler_type: handler_type,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var listen_method_template = template.Must(
+	template.New("listen_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(f {{.TypeString .ElementType}}) func() {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	h := x.{{.SlotName}}.Add(f)
+	return func() {
+		{{- if .Locked}}
+		x.defimpl_mutex.Lock()
+		defer x.defimpl_mutex.Unlock()
+		{{- end}}
+		x.{{.SlotName}}.Remove(h)
+	}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Listen) GlobalsTemplate() *template.Template {
+	return listen_method_template
+}
@@ -0,0 +1,79 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type MarshalVerbPhrase struct {
+	describingVerbPhrase
+}
+
+var _ VerbPhrase = (*MarshalVerbPhrase)(nil)
+
+
+type Verb_Marshal struct {}
+
+var _ VerbDefinition = (*Verb_Marshal)(nil)
+
+func init() {
+	vd := &Verb_Marshal{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Marshal) Tag() string { return "marshal" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Marshal) Description() string {
+	return "returns the object's slots encoded as a JSON object, e.g. for a MarshalJSON method, omitting slots that the presence verbs report as unset."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Marshal) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Marshal) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	_, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &MarshalVerbPhrase{
+		describingVerbPhrase: describingVerbPhrase{
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+		},
+	}
+	return vp, nil
+}
+
+var marshal_method_template = template.Must(
+	template.New("marshal_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() ([]byte, error) {
+	{{.Capture}}
+	m := map[string]any{}
+	{{- range .Described}}
+	{{- if .Present}}
+	if {{.Present}} {
+		m["{{.Name}}"] = {{.Value}}
+	}
+	{{- else}}
+	m["{{.Name}}"] = {{.Value}}
+	{{- end}}
+	{{- end}}
+	return json.Marshal(m)
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Marshal) GlobalsTemplate() *template.Template {
+	return marshal_method_template
+}
+
+// StructBody is part of the VerbDefinition interface.
+func (vd *Verb_Marshal) StructBody(VerbPhrase) (string, error) {
+	return "", nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type MembersVerbPhrase struct {
+	setVerbPhrase
+}
+
+var _ VerbPhrase = (*MembersVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*MembersVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*MembersVerbPhrase)(nil)
+
+
+type Verb_Members struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Members)(nil)
+
+func init() {
+	vd := &Verb_Members{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Members) Tag() string { return "members" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Members) Description() string {
+	return "returns a slice of the members of the set valued field."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Members) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Members) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newSetVerbPhrase(ctx, vd, idef, field, comment, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &MembersVerbPhrase{
+		setVerbPhrase: svp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var members_method_template = template.Must(
+	template.New("members_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() []{{.TypeString .ElementType}} {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{- if .Ordered}}
+	return x.{{.SlotName}}.Members()
+	{{- else}}
+	members := make([]{{.TypeString .ElementType}}, 0, len(x.{{.SlotName}}))
+	for v := range x.{{.SlotName}} {
+		members = append(members, v)
+	}
+	return members
+	{{- end}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Members) GlobalsTemplate() *template.Template {
+	return members_method_template
+}
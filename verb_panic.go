@@ -35,6 +35,12 @@ func (vd *Verb_Panic) Description() string {
 	return "the method will panic if called, for when an implementation only needs to partially implement an interface."
 }
 
+// Mutating is part of the VerbDefinition interface.
+//
+// A panicing method might stand in for anything, so it is assumed to
+// be mutating.
+func (vd *Verb_Panic) Mutating() bool { return true }
+
 // NewVerbPhrase is part of the VerbDefinition interface.
 func (vd *Verb_Panic) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
 	_, err, scratchpad := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
@@ -0,0 +1,108 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type PeekVerbPhrase struct {
+	sequenceVerbPhrase
+}
+
+var _ VerbPhrase = (*PeekVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*PeekVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*PeekVerbPhrase)(nil)
+
+
+type Verb_Peek struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Peek)(nil)
+
+func init() {
+	vd := &Verb_Peek{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Peek) Tag() string { return "peek" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Peek) Description() string {
+	return "returns the last element of the slice valued field, or the front element of the queue valued field, without removing it."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Peek) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Peek) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newSequenceVerbPhrase(ctx, vd, idef, field, comment,
+		peek_method_template, "peek_ok", "peek_panic")
+	if err != nil {
+		return nil, err
+	}
+	vp := &PeekVerbPhrase{
+		sequenceVerbPhrase: svp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var peek_method_template = template.Must(
+	template.New("peek_method_template").Parse(`
+{{- define "peek_get"}}
+	{{- if .Queue}}
+	v, _ := x.{{.SlotName}}.Front()
+	{{- else}}
+	v := x.{{.SlotName}}[len(x.{{.SlotName}}) - 1]
+	{{- end}}
+{{- end}}
+
+{{- define "peek_ok"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() ({{.TypeString .ElementType}}, bool) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	if {{if .Queue}}x.{{.SlotName}}.Len(){{else}}len(x.{{.SlotName}}){{end}} == 0 {
+		var zero {{.TypeString .ElementType}}
+		return zero, false
+	}
+	{{- template "peek_get" .}}
+	return v, true
+}
+{{end}}
+
+{{- define "peek_panic"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {{.TypeString .ElementType}} {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	if {{if .Queue}}x.{{.SlotName}}.Len(){{else}}len(x.{{.SlotName}}){{end}} == 0 {
+		panic("(*{{.StructName}}).{{.MethodName}}: {{.SlotName}} is empty")
+	}
+	{{- template "peek_get" .}}
+	return v
+}
+{{end}}
+
+{{- if eq .Form "peek_ok"}}{{template "peek_ok" .}}{{end}}
+{{- if eq .Form "peek_panic"}}{{template "peek_panic" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Peek) GlobalsTemplate() *template.Template {
+	return peek_method_template
+}
@@ -0,0 +1,138 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "text/template"
+
+
+type PopVerbPhrase struct {
+	sequenceVerbPhrase
+}
+
+var _ VerbPhrase = (*PopVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*PopVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*PopVerbPhrase)(nil)
+var _ Invertible = (*PopVerbPhrase)(nil)
+
+// JournalCapture is part of the Invertible interface.
+func (vp *PopVerbPhrase) JournalCapture() string {
+	return ""
+}
+
+// JournalUndo is part of the Invertible interface.
+func (vp *PopVerbPhrase) JournalUndo() string {
+	if vp.Queue() {
+		return fmt.Sprintf("x.%s.PushBack(v)", vp.SlotName())
+	}
+	return fmt.Sprintf("x.%s = append(x.%s, v)", vp.SlotName(), vp.SlotName())
+}
+
+// JournalRedo is part of the Invertible interface.
+func (vp *PopVerbPhrase) JournalRedo() string {
+	if vp.Queue() {
+		return fmt.Sprintf("x.%s.PopBack()", vp.SlotName())
+	}
+	slot := "x." + vp.SlotName()
+	return fmt.Sprintf("%s = slices.Delete(%s, len(%s) - 1, len(%s))", slot, slot, slot, slot)
+}
+
+
+
+type Verb_Pop struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Pop)(nil)
+
+func init() {
+	vd := &Verb_Pop{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Pop) Tag() string { return "pop" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Pop) Description() string {
+	return "removes and returns the last element of the slice or queue valued field."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Pop) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Pop) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newSequenceVerbPhrase(ctx, vd, idef, field, comment,
+		pop_method_template, "pop_ok", "pop_panic")
+	if err != nil {
+		return nil, err
+	}
+	vp := &PopVerbPhrase{
+		sequenceVerbPhrase: svp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var pop_method_template = template.Must(
+	template.New("pop_method_template").Parse(`
+{{- define "pop_get"}}
+	{{.BeforeMutation}}
+	{{- if .Queue}}
+	v, _ := x.{{.SlotName}}.PopBack()
+	{{- else}}
+	n := len(x.{{.SlotName}})
+	v := x.{{.SlotName}}[n - 1]
+	clear(x.{{.SlotName}}[n - 1:])
+	x.{{.SlotName}} = x.{{.SlotName}}[:n - 1]
+	{{- end}}
+	{{.AfterMutation}}
+{{- end}}
+
+{{- define "pop_ok"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() ({{.TypeString .ElementType}}, bool) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	if {{if .Queue}}x.{{.SlotName}}.Len(){{else}}len(x.{{.SlotName}}){{end}} == 0 {
+		var zero {{.TypeString .ElementType}}
+		return zero, false
+	}
+	{{- template "pop_get" .}}
+	return v, true
+}
+{{end}}
+
+{{- define "pop_panic"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {{.TypeString .ElementType}} {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	if {{if .Queue}}x.{{.SlotName}}.Len(){{else}}len(x.{{.SlotName}}){{end}} == 0 {
+		panic("(*{{.StructName}}).{{.MethodName}}: {{.SlotName}} is empty")
+	}
+	{{- template "pop_get" .}}
+	return v
+}
+{{end}}
+
+{{- if eq .Form "pop_ok"}}{{template "pop_ok" .}}{{end}}
+{{- if eq .Form "pop_panic"}}{{template "pop_panic" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Pop) GlobalsTemplate() *template.Template {
+	return pop_method_template
+}
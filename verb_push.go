@@ -0,0 +1,122 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type PushVerbPhrase struct {
+	sequenceVerbPhrase
+}
+
+var _ VerbPhrase = (*PushVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*PushVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*PushVerbPhrase)(nil)
+var _ Invertible = (*PushVerbPhrase)(nil)
+
+// JournalCapture is part of the Invertible interface.
+func (vp *PushVerbPhrase) JournalCapture() string {
+	return vp.journalPushBackCapture()
+}
+
+// JournalUndo is part of the Invertible interface.
+func (vp *PushVerbPhrase) JournalUndo() string {
+	return vp.journalPushBackUndo()
+}
+
+// JournalRedo is part of the Invertible interface.
+func (vp *PushVerbPhrase) JournalRedo() string {
+	return vp.journalPushBackRedo()
+}
+
+
+
+type Verb_Push struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Push)(nil)
+
+func init() {
+	vd := &Verb_Push{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Push) Tag() string { return "push" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Push) Description() string {
+	return "adds the specified values to the end of the slice or queue valued field."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Push) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Push) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newSequenceVerbPhrase(ctx, vd, idef, field, comment,
+		push_method_template, "push_variadic", "push_one")
+	if err != nil {
+		return nil, err
+	}
+	vp := &PushVerbPhrase{
+		sequenceVerbPhrase: svp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var push_method_template = template.Must(
+	template.New("push_method_template").Parse(`
+{{- define "push_element"}}
+	{{- if .Queue}}
+		x.{{.SlotName}}.PushBack(v)
+	{{- else}}
+		x.{{.SlotName}} = append(x.{{.SlotName}}, v)
+	{{- end}}
+{{- end}}
+
+{{- define "push_variadic"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(elements ...{{.TypeString .ElementType}}) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	for _, v := range elements {
+		{{- template "push_element" .}}
+	}
+	{{.AfterMutation}}
+}
+{{end}}
+
+{{- define "push_one"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(v {{.TypeString .ElementType}}) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	{{- template "push_element" .}}
+	{{.AfterMutation}}
+}
+{{end}}
+
+{{- if eq .Form "push_variadic"}}{{template "push_variadic" .}}{{end}}
+{{- if eq .Form "push_one"}}{{template "push_one" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Push) GlobalsTemplate() *template.Template {
+	return push_method_template
+}
@@ -0,0 +1,178 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+type RangeVerbPhrase struct {
+	slotVerbPhrase
+	// form identifies which of the templates in
+	// range_method_template matched the method signature.
+	form string
+}
+
+var _ VerbPhrase = (*RangeVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*RangeVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*RangeVerbPhrase)(nil)
+
+func (vp *RangeVerbPhrase) Form() string {
+	return vp.form
+}
+
+
+type Verb_Range struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Range)(nil)
+
+func init() {
+	vd := &Verb_Range{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Range) Tag() string { return "range" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Range) Description() string {
+	return "returns an iter.Seq of the elements of a slice valued field, an iter.Seq2 of their indices and elements, or an iter.Seq2 of the keys and values of a map valued field."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Range) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Range) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	// iter.Seq2[int, T] could iterate over either a slice or a
+	// map with int keys.  We assume a slice unless some previous
+	// verb has established that the slot is a map.  Should a later
+	// verb establish that, addSlotSpec calls rangeOverMap.
+	forms := []string{ "range_seq", "range_seq2", "range_map" }
+	for _, vp := range idef.VerbPhrases {
+		if svp, ok := vp.(SlotVerbPhrase); ok && svp.SlotName() == slot {
+			if _, ok := svp.SlotType().(*types.Map); ok {
+				forms = []string{ "range_map" }
+			}
+		}
+	}
+	var slot_type types.Type
+	form := ""
+	for _, f := range forms {
+		elt, err, scratchpad := CheckSignatures(ctx, vd, idef.Package(), field,
+			range_method_template.Lookup(f))
+		if err != nil {
+			continue
+		}
+		form = f
+		if f == "range_map" {
+			key := ctx.info.Types[scratchpad["_KEY_TYPE"].(ast.Expr)].Type
+			slot_type = types.NewMap(key, elt)
+		} else {
+			slot_type = types.NewSlice(elt)
+		}
+		break
+	}
+	if form == "" {
+		pos := ctx.fset.Position(comment.Slash)
+		return nil, fmt.Errorf("defimpl: %s: Method signature inappropriate for verb %q",
+			pos, vd.Tag())
+	}
+	vp := &RangeVerbPhrase{
+		slotVerbPhrase: slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+			slot_type: slot_type,
+		},
+		form: form,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+// rangeOverMap revises those range verbs of spec that assumed that
+// their slot is a slice once some other verb has established that it
+// is a map with int keys, so that the order in which the verbs of
+// the slot are declared doesn't matter.
+func rangeOverMap(spec *slotSpec, t types.Type) {
+	m, ok := t.(*types.Map)
+	if !ok || !types.Identical(m.Key(), types.Typ[types.Int]) {
+		return
+	}
+	for _, svp := range spec.VerbPhrases {
+		vp, ok := svp.(*RangeVerbPhrase)
+		if !ok || vp.form != "range_seq2" {
+			continue
+		}
+		if types.Identical(vp.slot_type.(*types.Slice).Elem(), m.Elem()) {
+			vp.form = "range_map"
+			vp.slot_type = types.NewMap(m.Key(), m.Elem())
+		}
+	}
+}
+
+var range_method_template = template.Must(
+	template.New("range_method_template").Parse(`
+{{- define "range_seq"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() iter.Seq[{{.TypeString .SlotType.Elem}}] {
+	{{.Elements}}
+	return func(yield func({{.TypeString .SlotType.Elem}}) bool) {
+		for _, v := range elements {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+{{end}}
+
+{{- define "range_seq2"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() iter.Seq2[int, {{.TypeString .SlotType.Elem}}] {
+	{{.Elements}}
+	return func(yield func(int, {{.TypeString .SlotType.Elem}}) bool) {
+		for i, v := range elements {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+{{end}}
+
+{{- define "range_map"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() iter.Seq2[{{.TypeString .SlotType.Key}}, {{.TypeString .SlotType.Elem}}] {
+	{{.Elements}}
+	return func(yield func({{.TypeString .SlotType.Key}}, {{.TypeString .SlotType.Elem}}) bool) {
+		for k, v := range elements {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+{{end}}
+
+{{- if eq .Form "range_seq"}}{{template "range_seq" .}}{{end}}
+{{- if eq .Form "range_seq2"}}{{template "range_seq2" .}}{{end}}
+{{- if eq .Form "range_map"}}{{template "range_map" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Range) GlobalsTemplate() *template.Template {
+	return range_method_template
+}
@@ -29,9 +29,12 @@ func (vd *Verb_Read) Tag() string { return "read" }
 
 // Description is part of the VerbDefinition interface.
 func (vd *Verb_Read) Description() string {
-	return "returns the value of the field."
+	return "returns the value of the field, or a copy of it if the field has the copy:\"true\" option.  A nil slice or map valued field is first allocated."
 }
 
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Read) Mutating() bool { return false }
+
 // NewVerbPhrase is part of the VerbDefinition interface.
 func (vd *Verb_Read) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
 	slot, err := parse_slot_verb_phrase(ctx, field, comment)
@@ -61,7 +64,28 @@ var read_method_template = template.Must(
 	template.New("read_method_template").Parse(`
 // {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
 func (x *{{.StructName}}) {{.MethodName}}() {{.TypeString .SlotType}} {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- if .Atomic}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	return x.{{.SlotName}}.Load()
+	{{- else}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{- with .Allocate}}
+	{{.}}
+	{{- end}}
+	{{- if .Copy}}
+	return {{.SlotSpec.CopyExpression (printf "x.%s" .SlotName)}}
+	{{- else}}
 	return x.{{.SlotName}}
+	{{- end}}
+	{{- end}}
 }
 `))
 
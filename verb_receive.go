@@ -0,0 +1,80 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type ReceiveVerbPhrase struct {
+	chanVerbPhrase
+}
+
+var _ VerbPhrase = (*ReceiveVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*ReceiveVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*ReceiveVerbPhrase)(nil)
+
+
+type Verb_Receive struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Receive)(nil)
+
+func init() {
+	vd := &Verb_Receive{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Receive) Tag() string { return "receive" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Receive) Description() string {
+	return "receives a value from the channel valued field, returning it and false if the channel has been closed, or returns the channel itself, receive only."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Receive) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Receive) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	cvp, err := newChanVerbPhrase(ctx, vd, idef, field, comment,
+		receive_method_template, "receive_ok", "receive_chan")
+	if err != nil {
+		return nil, err
+	}
+	vp := &ReceiveVerbPhrase{
+		chanVerbPhrase: cvp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var receive_method_template = template.Must(
+	template.New("receive_method_template").Parse(`
+{{- define "receive_ok"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() ({{.TypeString .ElementType}}, bool) {
+	{{.Channel}}
+	v, ok := <-ch
+	return v, ok
+}
+{{end}}
+
+{{- define "receive_chan"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() <-chan {{.TypeString .ElementType}} {
+	{{.Channel}}
+	return ch
+}
+{{end}}
+
+{{- if eq .Form "receive_ok"}}{{template "receive_ok" .}}{{end}}
+{{- if eq .Form "receive_chan"}}{{template "receive_chan" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Receive) GlobalsTemplate() *template.Template {
+	return receive_method_template
+}
@@ -0,0 +1,109 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "text/template"
+
+
+type RemoveVerbPhrase struct {
+	setVerbPhrase
+}
+
+var _ VerbPhrase = (*RemoveVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*RemoveVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*RemoveVerbPhrase)(nil)
+var _ Invertible = (*RemoveVerbPhrase)(nil)
+
+// JournalCapture is part of the Invertible interface.  The position of
+// a member of an ordered set is recorded so that undoing its removal
+// restores the order.
+func (vp *RemoveVerbPhrase) JournalCapture() string {
+	if vp.Ordered() {
+		return fmt.Sprintf("defimpl_at, _ := x.%s.Index(v)", vp.SlotName())
+	}
+	return ""
+}
+
+// JournalUndo is part of the Invertible interface.
+func (vp *RemoveVerbPhrase) JournalUndo() string {
+	if vp.Ordered() {
+		return fmt.Sprintf("x.%s.Insert(defimpl_at, v)", vp.SlotName())
+	}
+	return vp.addMember("v")
+}
+
+// JournalRedo is part of the Invertible interface.
+func (vp *RemoveVerbPhrase) JournalRedo() string {
+	return vp.removeMember("v")
+}
+
+
+
+type Verb_Remove struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Remove)(nil)
+
+func init() {
+	vd := &Verb_Remove{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Remove) Tag() string { return "remove" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Remove) Description() string {
+	return "removes the specified value from the set valued field."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Remove) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Remove) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newSetVerbPhrase(ctx, vd, idef, field, comment, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &RemoveVerbPhrase{
+		setVerbPhrase: svp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var remove_method_template = template.Must(
+	template.New("remove_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(v {{.TypeString .ElementType}}) {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{- if .Ordered}}
+	if x.{{.SlotName}}.Contains(v) {
+		{{.BeforeMutation}}
+		x.{{.SlotName}}.Remove(v)
+		{{.AfterMutation}}
+	}
+	{{- else}}
+	if _, ok := x.{{.SlotName}}[v]; ok {
+		{{.BeforeMutation}}
+		delete(x.{{.SlotName}}, v)
+		{{.AfterMutation}}
+	}
+	{{- end}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Remove) GlobalsTemplate() *template.Template {
+	return remove_method_template
+}
@@ -0,0 +1,116 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+type RemoveAtVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*RemoveAtVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*RemoveAtVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*RemoveAtVerbPhrase)(nil)
+var _ Invertible = (*RemoveAtVerbPhrase)(nil)
+
+// JournalCapture is part of the Invertible interface.
+func (vp *RemoveAtVerbPhrase) JournalCapture() string {
+	return ""
+}
+
+// JournalUndo is part of the Invertible interface.
+func (vp *RemoveAtVerbPhrase) JournalUndo() string {
+	return fmt.Sprintf("x.%s = slices.Insert(x.%s, index, v)", vp.SlotName(), vp.SlotName())
+}
+
+// JournalRedo is part of the Invertible interface.
+func (vp *RemoveAtVerbPhrase) JournalRedo() string {
+	return fmt.Sprintf("x.%s = slices.Delete(x.%s, index, index + 1)", vp.SlotName(), vp.SlotName())
+}
+
+
+
+type Verb_RemoveAt struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_RemoveAt)(nil)
+
+func init() {
+	vd := &Verb_RemoveAt{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_RemoveAt) Tag() string { return "removeat" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_RemoveAt) Description() string {
+	return "removes and returns the element of the slice valued field at the specified (zero based) index."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_RemoveAt) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_RemoveAt) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	slot_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &RemoveAtVerbPhrase{
+		slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+			slot_type: types.NewSlice(slot_type),
+		},
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var removeat_method_template = template.Must(
+	template.New("removeat_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}} (index int) {{.TypeString .SlotType.Elem}} {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	if index < 0 || index >= len(x.{{.SlotName}}) {
+		panic(fmt.Sprintf("(*{{.StructName}}).{{.MethodName}}: index %d out of range for {{.SlotName}} of length %d",
+			index, len(x.{{.SlotName}})))
+	}
+	{{.BeforeMutation}}
+	v := x.{{.SlotName}}[index]
+	n := len(x.{{.SlotName}})
+	copy(x.{{.SlotName}}[index:], x.{{.SlotName}}[index+1:])
+	clear(x.{{.SlotName}}[n - 1:])
+	x.{{.SlotName}} = x.{{.SlotName}}[:n - 1]
+	{{.AfterMutation}}
+	{{- with .Children}}
+	v.{{.Setter}}(nil)
+	{{- end}}
+	return v
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_RemoveAt) GlobalsTemplate() *template.Template {
+	return removeat_method_template
+}
@@ -0,0 +1,135 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+type ReplaceVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*ReplaceVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*ReplaceVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*ReplaceVerbPhrase)(nil)
+var _ Invertible = (*ReplaceVerbPhrase)(nil)
+
+// JournalCapture is part of the Invertible interface.
+func (vp *ReplaceVerbPhrase) JournalCapture() string {
+	return ""
+}
+
+// JournalUndo is part of the Invertible interface.
+func (vp *ReplaceVerbPhrase) JournalUndo() string {
+	return fmt.Sprintf("x.%s[index] = old", vp.SlotName())
+}
+
+// JournalRedo is part of the Invertible interface.
+func (vp *ReplaceVerbPhrase) JournalRedo() string {
+	return fmt.Sprintf("x.%s[index] = v", vp.SlotName())
+}
+
+
+
+type Verb_Replace struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Replace)(nil)
+
+func init() {
+	vd := &Verb_Replace{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Replace) Tag() string { return "replace" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Replace) Description() string {
+	return "replaces the element of the slice valued field at the specified (zero based) index with the specified value and returns the element that was replaced."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Replace) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Replace) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	// Placing an element at an arbitrary index would defeat the
+	// order option.
+	if slotOption(idef, slot, "order") != "" {
+		return nil, fmt.Errorf("defimpl: %s: verb %q can't be used with sorted slot %q",
+			ctx.fset.Position(comment.Slash), vd.Tag(), slot)
+	}
+	slot_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := getSlotChildren(idef, slot, slot_type); err != nil {
+		return nil, err
+	}
+	vp := &ReplaceVerbPhrase{
+		slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+			slot_type: types.NewSlice(slot_type),
+		},
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var replace_method_template = template.Must(
+	template.New("replace_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}} (index int, v {{.TypeString .SlotType.Elem}}) {{.TypeString .SlotType.Elem}} {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	if index < 0 || index >= len(x.{{.SlotName}}) {
+		panic(fmt.Sprintf("(*{{.StructName}}).{{.MethodName}}: index %d out of range for {{.SlotName}} of length %d",
+			index, len(x.{{.SlotName}})))
+	}
+	{{- with .Children}}
+	if x.{{$.SlotName}}[index] == v {
+		return v
+	}
+	// v might already be in the slot, ahead of where it's going.
+	if j := slices.Index(x.{{$.SlotName}}, v); j >= 0 && j < index {
+		index--
+	}
+	if p := v.{{.Getter}}(); p != nil {
+		p.{{.Remove}}(v)
+	}
+	{{- end}}
+	{{.BeforeMutation}}
+	old := x.{{.SlotName}}[index]
+	x.{{.SlotName}}[index] = v
+	{{.AfterMutation}}
+	{{- with .Children}}
+	old.{{.Setter}}(nil)
+	v.{{.Setter}}(x)
+	{{- end}}
+	return old
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Replace) GlobalsTemplate() *template.Template {
+	return replace_method_template
+}
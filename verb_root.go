@@ -0,0 +1,66 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type RootVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*RootVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*RootVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*RootVerbPhrase)(nil)
+
+
+type Verb_Root struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Root)(nil)
+
+func init() {
+	vd := &Verb_Root{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Root) Tag() string { return "root" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Root) Description() string {
+	return "returns the root of the tree of which the object is a part, following the parents of the elements of a slot with the children option."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Root) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Root) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newTreeVerbPhrase(ctx, vd, idef, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	vp := &RootVerbPhrase{ svp }
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var root_method_template = template.Must(
+	template.New("root_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {{.TypeString .SlotType.Elem}} {
+	var n {{.TypeString .SlotType.Elem}} = x
+	for p := n.{{.Children.Getter}}(); p != nil; p = n.{{.Children.Getter}}() {
+		n = p
+	}
+	return n
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Root) GlobalsTemplate() *template.Template {
+	return root_method_template
+}
@@ -0,0 +1,70 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type SendVerbPhrase struct {
+	chanVerbPhrase
+}
+
+var _ VerbPhrase = (*SendVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*SendVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*SendVerbPhrase)(nil)
+
+
+type Verb_Send struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Send)(nil)
+
+func init() {
+	vd := &Verb_Send{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Send) Tag() string { return "send" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Send) Description() string {
+	return "sends the specified value on the channel valued field, which is made when it is first needed.  It has the capacity given by the buffer option, e.g. buffer:\"10\", or is unbuffered."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Send) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Send) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	cvp, err := newChanVerbPhrase(ctx, vd, idef, field, comment,
+		send_method_template, "send")
+	if err != nil {
+		return nil, err
+	}
+	vp := &SendVerbPhrase{
+		chanVerbPhrase: cvp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var send_method_template = template.Must(
+	template.New("send_method_template").Parse(`
+{{- define "send"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(v {{.TypeString .ElementType}}) {
+	{{.Channel}}
+	ch <- v
+}
+{{end}}
+
+{{- if eq .Form "send"}}{{template "send" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Send) GlobalsTemplate() *template.Template {
+	return send_method_template
+}
@@ -1,17 +1,26 @@
 package main
 
+import "errors"
+import "fmt"
 import "go/ast"
 import "text/template"
 
 
 type SetVerbPhrase struct {
 	slotVerbPhrase
+	// form identifies which of the verb's templates matched the
+	// method signature.
+	form string
 }
 
 var _ VerbPhrase = (*SetVerbPhrase)(nil)
 var _ SlotVerbPhrase = (*SetVerbPhrase)(nil)
 var _ GlobalsTemplateParameter = (*SetVerbPhrase)(nil)
 
+func (vp *SetVerbPhrase) Form() string {
+	return vp.form
+}
+
 
 type Verb_Set struct {
 	slotVerbDefinition
@@ -29,40 +38,96 @@ func (vd *Verb_Set) Tag() string { return "set" }
 
 // Description is part of the VerbDefinition interface.
 func (vd *Verb_Set) Description() string {
-	return "sets the value of the field to that provided."
+	return "sets the value of the field to that provided, or to a copy of it if the field has the copy:\"true\" option."
 }
 
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Set) Mutating() bool { return true }
+
 // NewVerbPhrase is part of the VerbDefinition interface.
 func (vd *Verb_Set) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
 	slot, err := parse_slot_verb_phrase(ctx, field, comment)
 	if err != nil {
 		return nil, err
 	}
-	slot_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
-	if err != nil {
+	if err := rejectChildren(ctx, vd, idef, slot, comment); err != nil {
 		return nil, err
 	}
+	// Setting the slot to an unsorted value would defeat the order
+	// option.
+	if slotOption(idef, slot, "order") != "" {
+		return nil, fmt.Errorf("defimpl: %s: verb %q can't be used with sorted slot %q",
+			ctx.fset.Position(comment.Slash), vd.Tag(), slot)
+	}
 	vp := &SetVerbPhrase{
-		slotVerbPhrase {
+		slotVerbPhrase: slotVerbPhrase {
 			baseVerbPhrase: baseVerbPhrase {
 				verb: vd,
 				idef: idef,
 				field: field,
 			},
 			slot_name: slot,
-			slot_type: slot_type,
 		},
 	}
+	// A fluent setter, e.g. WithName(string) Thing, returns the
+	// object for chaining.
+	for _, form := range []string{ "set", "set_fluent" } {
+		slot_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate().Lookup(form))
+		if err == nil {
+			vp.slot_type = slot_type
+			vp.form = form
+			break
+		}
+		if errors.Is(err, errNotOwnInterface) {
+			return nil, err
+		}
+	}
+	if vp.form == "" {
+		return nil, fmt.Errorf("defimpl: %s: Method signature inappropriate for verb %q",
+			ctx.fset.Position(comment.Slash), vd.Tag())
+	}
 	addSlotSpec(idef, vp)
 	return vp, nil
 }
 
 var set_method_template = template.Must(
 	template.New("set_method_template").Parse(`
+{{- define "set_body"}}
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	{{- if .Atomic}}
+	x.{{.SlotName}}.Store(v)
+	{{- else if .Copy}}
+	x.{{.SlotName}} = {{.SlotSpec.CopyExpression "v"}}
+	{{- else}}
+	x.{{.SlotName}} = v
+	{{- end}}
+	{{.AfterMutation}}
+{{- end}}
+
+{{- define "set"}}
 // {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
 func (x *{{.StructName}}) {{.MethodName}}(v {{.TypeString .SlotType}}) {
-	x.{{.SlotName}} = v
+	{{- template "set_body" .}}
 }
+{{end}}
+
+{{- define "set_fluent"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(v {{.TypeString .SlotType}}) {{.InterfaceName}} {
+	{{- template "set_body" .}}
+	return x
+}
+{{end}}
+
+{{- if eq .Form "set"}}{{template "set" .}}{{end}}
+{{- if eq .Form "set_fluent"}}{{template "set_fluent" .}}{{end}}
 `))
 
 // GlobalsTemplate is part of the VerbDefinition interface.
@@ -0,0 +1,74 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type SetBitVerbPhrase struct {
+	bitVerbPhrase
+}
+
+var _ VerbPhrase = (*SetBitVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*SetBitVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*SetBitVerbPhrase)(nil)
+
+
+type Verb_SetBit struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_SetBit)(nil)
+
+func init() {
+	vd := &Verb_SetBit{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_SetBit) Tag() string { return "setbit" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_SetBit) Description() string {
+	return "sets the bit, named by the bit option, of the integer valued field."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_SetBit) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_SetBit) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	fvp, err := newBitVerbPhrase(ctx, vd, idef, field, comment,
+		setbit_method_template, setbit_method_template.Name())
+	if err != nil {
+		return nil, err
+	}
+	vp := &SetBitVerbPhrase{
+		bitVerbPhrase: fvp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var setbit_method_template = template.Must(
+	template.New("setbit_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	x.{{.SlotName}} |= {{.Bit}}
+	{{.AfterMutation}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_SetBit) GlobalsTemplate() *template.Template {
+	return setbit_method_template
+}
@@ -0,0 +1,79 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type StringVerbPhrase struct {
+	describingVerbPhrase
+}
+
+var _ VerbPhrase = (*StringVerbPhrase)(nil)
+
+
+type Verb_String struct {}
+
+var _ VerbDefinition = (*Verb_String)(nil)
+
+func init() {
+	vd := &Verb_String{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_String) Tag() string { return "string" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_String) Description() string {
+	return "returns a description of the object's slots, e.g. for a String method, omitting slots that the presence verbs report as unset."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_String) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_String) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	_, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &StringVerbPhrase{
+		describingVerbPhrase: describingVerbPhrase{
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+		},
+	}
+	return vp, nil
+}
+
+var string_method_template = template.Must(
+	template.New("string_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() string {
+	{{.Capture}}
+	parts := []string{}
+	{{- range .Described}}
+	{{- if .Present}}
+	if {{.Present}} {
+		parts = append(parts, fmt.Sprintf("{{.Name}}: {{.Format}}", {{.Value}}))
+	}
+	{{- else}}
+	parts = append(parts, fmt.Sprintf("{{.Name}}: {{.Format}}", {{.Value}}))
+	{{- end}}
+	{{- end}}
+	return "{{.StructName}}{" + strings.Join(parts, ", ") + "}"
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_String) GlobalsTemplate() *template.Template {
+	return string_method_template
+}
+
+// StructBody is part of the VerbDefinition interface.
+func (vd *Verb_String) StructBody(VerbPhrase) (string, error) {
+	return "", nil
+}
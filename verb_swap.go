@@ -0,0 +1,94 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type SwapVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*SwapVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*SwapVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*SwapVerbPhrase)(nil)
+
+
+type Verb_Swap struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Swap)(nil)
+
+func init() {
+	vd := &Verb_Swap{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Swap) Tag() string { return "swap" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Swap) Description() string {
+	return "sets the value of the field to that provided, returning the previous value."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Swap) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Swap) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	slot, err := parse_slot_verb_phrase(ctx, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	if err := rejectChildren(ctx, vd, idef, slot, comment); err != nil {
+		return nil, err
+	}
+	slot_type, err, _ := CheckSignatures(ctx, vd, idef.Package(), field, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	vp := &SwapVerbPhrase{
+		slotVerbPhrase {
+			baseVerbPhrase: baseVerbPhrase {
+				verb: vd,
+				idef: idef,
+				field: field,
+			},
+			slot_name: slot,
+			slot_type: slot_type,
+		},
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var swap_method_template = template.Must(
+	template.New("swap_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(v {{.TypeString .SlotType}}) {{.TypeString .SlotType}} {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	{{- if .Atomic}}
+	old := x.{{.SlotName}}.Swap(v)
+	{{- else}}
+	old := x.{{.SlotName}}
+	x.{{.SlotName}} = v
+	{{- end}}
+	{{.AfterMutation}}
+	return old
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Swap) GlobalsTemplate() *template.Template {
+	return swap_method_template
+}
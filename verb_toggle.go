@@ -0,0 +1,96 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type ToggleVerbPhrase struct {
+	flagVerbPhrase
+}
+
+var _ VerbPhrase = (*ToggleVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*ToggleVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*ToggleVerbPhrase)(nil)
+
+
+type Verb_Toggle struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Toggle)(nil)
+
+func init() {
+	vd := &Verb_Toggle{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Toggle) Tag() string { return "toggle" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Toggle) Description() string {
+	return "negates the bool valued field, optionally returning the new value."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Toggle) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Toggle) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	fvp, err := newFlagVerbPhrase(ctx, vd, idef, field, comment,
+		toggle_method_template, "toggle_result", "toggle")
+	if err != nil {
+		return nil, err
+	}
+	vp := &ToggleVerbPhrase{
+		flagVerbPhrase: fvp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var toggle_method_template = template.Must(
+	template.New("toggle_method_template").Parse(`
+{{- define "toggle"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	x.{{.SlotName}} = !x.{{.SlotName}}
+	{{.AfterMutation}}
+}
+{{end}}
+
+{{- define "toggle_result"}}
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() bool {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	{{.BeforeMutation}}
+	x.{{.SlotName}} = !x.{{.SlotName}}
+	{{.AfterMutation}}
+	return x.{{.SlotName}}
+}
+{{end}}
+
+{{- if eq .Form "toggle"}}{{template "toggle" .}}{{end}}
+{{- if eq .Form "toggle_result"}}{{template "toggle_result" .}}{{end}}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Toggle) GlobalsTemplate() *template.Template {
+	return toggle_method_template
+}
@@ -0,0 +1,74 @@
+package main
+
+import "go/ast"
+import "text/template"
+
+
+type UnsetVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*UnsetVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*UnsetVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*UnsetVerbPhrase)(nil)
+
+
+type Verb_Unset struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Unset)(nil)
+
+func init() {
+	vd := &Verb_Unset{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Unset) Tag() string { return "unset" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Unset) Description() string {
+	return "restores the field to its initial value and records that it has not been set."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Unset) Mutating() bool { return true }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Unset) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newPresenceVerbPhrase(ctx, vd, idef, field, comment, vd.GlobalsTemplate())
+	if err != nil {
+		return nil, err
+	}
+	if err := rejectChildren(ctx, vd, idef, svp.SlotName(), comment); err != nil {
+		return nil, err
+	}
+	vp := &UnsetVerbPhrase{
+		slotVerbPhrase: svp,
+	}
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var unset_method_template = template.Must(
+	template.New("unset_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}() {
+	{{- if .Locked}}
+	x.defimpl_mutex.Lock()
+	defer x.defimpl_mutex.Unlock()
+	{{- end}}
+	{{.BeforeMutation}}
+	{{.Reset}}
+	{{.MarkAbsent}}
+	{{.AfterMutation}}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Unset) GlobalsTemplate() *template.Template {
+	return unset_method_template
+}
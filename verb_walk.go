@@ -0,0 +1,77 @@
+package main
+
+import "fmt"
+import "go/ast"
+import "go/types"
+import "text/template"
+
+
+type WalkVerbPhrase struct {
+	slotVerbPhrase
+}
+
+var _ VerbPhrase = (*WalkVerbPhrase)(nil)
+var _ SlotVerbPhrase = (*WalkVerbPhrase)(nil)
+var _ GlobalsTemplateParameter = (*WalkVerbPhrase)(nil)
+
+
+type Verb_Walk struct {
+	slotVerbDefinition
+}
+
+var _ VerbDefinition = (*Verb_Walk)(nil)
+
+func init() {
+	vd := &Verb_Walk{}
+	VerbDefinitions[vd.Tag()] = vd
+}
+
+// Tag is part of the VerbDefinition interface.
+func (vd *Verb_Walk) Tag() string { return "walk" }
+
+// Description is part of the VerbDefinition interface.
+func (vd *Verb_Walk) Description() string {
+	return "calls the specified function on the object and then on each of the elements of a slot with the children option, recursively.  If the function returns false the elements of that object aren't visited."
+}
+
+// Mutating is part of the VerbDefinition interface.
+func (vd *Verb_Walk) Mutating() bool { return false }
+
+// NewVerbPhrase is part of the VerbDefinition interface.
+func (vd *Verb_Walk) NewVerbPhrase(ctx *context, idef *InterfaceDefinition, field *ast.Field, comment *ast.Comment) (VerbPhrase, error) {
+	svp, err := newTreeVerbPhrase(ctx, vd, idef, field, comment)
+	if err != nil {
+		return nil, err
+	}
+	if lookupMethod(svp.slot_type.(*types.Slice).Elem(), svp.MethodName()) == nil {
+		return nil, fmt.Errorf("defimpl: %s: verb %q: the children of slot %q have no method %s",
+			ctx.fset.Position(comment.Slash), vd.Tag(), svp.SlotName(), svp.MethodName())
+	}
+	vp := &WalkVerbPhrase{ svp }
+	if err := addSlotSpec(idef, vp); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+var walk_method_template = template.Must(
+	template.New("walk_method_template").Parse(`
+// {{.MethodName}} is part of the {{.InterfaceName}} interface.  defimpl verb {{.Verb.Tag}}.
+func (x *{{.StructName}}) {{.MethodName}}(f func({{.TypeString .SlotType.Elem}}) bool) {
+	{{- with .Initialize}}
+	{{.}}
+	{{- end}}
+	if !f(x) {
+		return
+	}
+	// Copy the slot so that f can add or remove children.
+	for _, c := range append([]{{.TypeString .SlotType.Elem}}(nil), x.{{.SlotName}}...) {
+		c.{{.MethodName}}(f)
+	}
+}
+`))
+
+// GlobalsTemplate is part of the VerbDefinition interface.
+func (vd *Verb_Walk) GlobalsTemplate() *template.Template {
+	return walk_method_template
+}